@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/memberentitlementmanagementextras (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	memberentitlementmanagement "github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+	memberentitlementmanagementextras "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/memberentitlementmanagementextras"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMemberentitlementmanagementextrasClient is a mock of Client interface.
+type MockMemberentitlementmanagementextrasClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMemberentitlementmanagementextrasClientMockRecorder
+	isgomock struct{}
+}
+
+// MockMemberentitlementmanagementextrasClientMockRecorder is the mock recorder for MockMemberentitlementmanagementextrasClient.
+type MockMemberentitlementmanagementextrasClientMockRecorder struct {
+	mock *MockMemberentitlementmanagementextrasClient
+}
+
+// NewMockMemberentitlementmanagementextrasClient creates a new mock instance.
+func NewMockMemberentitlementmanagementextrasClient(ctrl *gomock.Controller) *MockMemberentitlementmanagementextrasClient {
+	mock := &MockMemberentitlementmanagementextrasClient{ctrl: ctrl}
+	mock.recorder = &MockMemberentitlementmanagementextrasClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMemberentitlementmanagementextrasClient) EXPECT() *MockMemberentitlementmanagementextrasClientMockRecorder {
+	return m.recorder
+}
+
+// SearchUserEntitlements mocks base method.
+func (m *MockMemberentitlementmanagementextrasClient) SearchUserEntitlements(arg0 context.Context, arg1 memberentitlementmanagement.SearchUserEntitlementsArgs) (*memberentitlementmanagementextras.PagedUserEntitlements, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUserEntitlements", arg0, arg1)
+	ret0, _ := ret[0].(*memberentitlementmanagementextras.PagedUserEntitlements)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUserEntitlements indicates an expected call of SearchUserEntitlements.
+func (mr *MockMemberentitlementmanagementextrasClientMockRecorder) SearchUserEntitlements(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUserEntitlements", reflect.TypeOf((*MockMemberentitlementmanagementextrasClient)(nil).SearchUserEntitlements), arg0, arg1)
+}
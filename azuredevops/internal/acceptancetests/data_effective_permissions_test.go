@@ -0,0 +1,75 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+// TestAccDataEffectivePermissions_basic verifies that explicit and effective permissions of a principal are returned
+func TestAccDataEffectivePermissions_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_effective_permissions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDataEffectivePermissionsBasic(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "inherit", "true"),
+					resource.TestCheckResourceAttr(tfNode, "explicit_permissions.GENERIC_READ", "allow"),
+					resource.TestCheckResourceAttr(tfNode, "explicit_permissions.DELETE", "deny"),
+					resource.TestCheckResourceAttr(tfNode, "effective_permissions.GENERIC_READ", "allow"),
+					resource.TestCheckResourceAttr(tfNode, "effective_permissions.DELETE", "deny"),
+				),
+			},
+		},
+	})
+}
+
+func hclDataEffectivePermissionsBasic(projectName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "project" {
+  name = "%s"
+}
+
+data "azuredevops_security_namespace" "project" {
+  name = "Project"
+}
+
+data "azuredevops_security_namespace_token" "project" {
+  namespace_name = "Project"
+  identifiers = {
+    project_id = azuredevops_project.project.id
+  }
+}
+
+data "azuredevops_identity_group" "test" {
+  project_id = azuredevops_project.project.id
+  name       = "[${azuredevops_project.project.name}]\\Readers"
+}
+
+resource "azuredevops_security_permissions" "test" {
+  namespace_id = data.azuredevops_security_namespace.project.id
+  token        = data.azuredevops_security_namespace_token.project.token
+  principal    = data.azuredevops_identity_group.test.subject_descriptor
+  permissions = {
+    GENERIC_READ = "allow"
+    DELETE       = "deny"
+  }
+  replace = false
+}
+
+data "azuredevops_effective_permissions" "test" {
+  namespace_id = azuredevops_security_permissions.test.namespace_id
+  token        = azuredevops_security_permissions.test.token
+  principal    = azuredevops_security_permissions.test.principal
+}
+`, projectName)
+}
@@ -44,6 +44,36 @@ func TestAccGitRepository_DataSource_notExist(t *testing.T) {
 	})
 }
 
+func TestAccGitRepository_DataSource_includeBranches(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_git_repository.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { testutils.PreCheck(t, nil) },
+		Providers:                 testutils.GetProviders(),
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDataRepositoryIncludeBranches(projectName, gitRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "branches.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "branches.*", map[string]string{
+						"name":         "master",
+						"is_default":   "true",
+						"has_policies": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "branches.*", map[string]string{
+						"name":         "feature",
+						"is_default":   "false",
+						"ahead_count":  "0",
+						"behind_count": "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func hclDataRepository(projectName string) string {
 	return fmt.Sprintf(`
 resource "azuredevops_project" "test" {
@@ -69,3 +99,33 @@ data "azuredevops_git_repository" "test" {
 }
 `, name)
 }
+
+func hclDataRepositoryIncludeBranches(projectName, gitRepoName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name = "%[1]s"
+}
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[2]s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_git_repository_branch" "test" {
+  repository_id = azuredevops_git_repository.test.id
+  name          = "feature"
+  ref_branch    = "master"
+}
+
+data "azuredevops_git_repository" "test" {
+  project_id       = azuredevops_project.test.id
+  name             = azuredevops_git_repository.test.name
+  include_branches = true
+
+  depends_on = [azuredevops_git_repository_branch.test]
+}
+`, projectName, gitRepoName)
+}
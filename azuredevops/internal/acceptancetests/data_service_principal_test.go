@@ -75,3 +75,35 @@ data "azuredevops_service_principal" "test" {
   depends_on = [azuredevops_service_principal_entitlement.test]
 }`, testutils.HclServicePrincipleEntitlementResource(servicePrincipalObjectId), servicePrincipalObjectId)
 }
+
+func TestAccServicePrincipalsDataSource_Read_HappyPath(t *testing.T) {
+	if os.Getenv("AZDO_TEST_AAD_SERVICE_PRINCIPAL_OBJECT_ID") == "" {
+		t.Skip("Skip test due to `AZDO_TEST_AAD_SERVICE_PRINCIPAL_OBJECT_ID` not set")
+	}
+	servicePrincipalObjectId := os.Getenv("AZDO_TEST_AAD_SERVICE_PRINCIPAL_OBJECT_ID")
+
+	tfNode := "data.azuredevops_service_principals.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclServicePrincipalsDataByClientId(servicePrincipalObjectId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "service_principals.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "service_principals.0.origin_id", servicePrincipalObjectId),
+					resource.TestCheckResourceAttrSet(tfNode, "service_principals.0.descriptor"),
+				),
+			},
+		},
+	})
+}
+
+func hclServicePrincipalsDataByClientId(servicePrincipalObjectId string) string {
+	return fmt.Sprintf(`
+%s
+data "azuredevops_service_principals" "test" {
+  client_id = azuredevops_service_principal_entitlement.test.client_id
+}`, testutils.HclServicePrincipleEntitlementResource(servicePrincipalObjectId))
+}
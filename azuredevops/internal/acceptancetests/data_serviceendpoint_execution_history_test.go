@@ -0,0 +1,51 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServiceEndpointExecutionHistory_dataSource(t *testing.T) {
+	name := testutils.GenerateResourceName()
+
+	tfNode := "data.azuredevops_serviceendpoint_execution_history.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclServiceEndpointExecutionHistoryDataSource(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "executions.#", "0"),
+					resource.TestCheckResourceAttr(tfNode, "last_used", ""),
+				),
+			},
+		},
+	})
+}
+
+func hclServiceEndpointExecutionHistoryDataSource(name string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%[1]s"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_serviceendpoint_generic" "test" {
+  project_id            = azuredevops_project.test.id
+  service_endpoint_name = "%[1]s"
+  server_url            = "https://some-server.example.com"
+}
+
+data "azuredevops_serviceendpoint_execution_history" "test" {
+  project_id          = azuredevops_project.test.id
+  service_endpoint_id = azuredevops_serviceendpoint_generic.test.id
+}
+`, name)
+}
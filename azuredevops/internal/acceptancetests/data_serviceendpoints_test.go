@@ -0,0 +1,63 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServiceEndpoints_dataSource(t *testing.T) {
+	name := testutils.GenerateResourceName()
+
+	tfNode := "data.azuredevops_serviceendpoints.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclServiceEndpointsDataSource(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "service_endpoints.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "service_endpoints.0.name", name),
+					resource.TestCheckResourceAttr(tfNode, "service_endpoints.0.type", "externalnpmregistry"),
+					resource.TestCheckResourceAttr(tfNode, "service_endpoints.0.is_ready", "true"),
+					resource.TestCheckResourceAttr(tfNode, "service_endpoints.0.is_shared", "false"),
+				),
+			},
+		},
+	})
+}
+
+func hclServiceEndpointsDataSource(name string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%[1]s"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_serviceendpoint_npm" "test" {
+  project_id            = azuredevops_project.test.id
+  service_endpoint_name = "%[1]s"
+  access_token          = "redacted"
+  url                   = "http://url.com/"
+}
+
+resource "azuredevops_serviceendpoint_generic" "test" {
+  project_id            = azuredevops_project.test.id
+  service_endpoint_name = "%[1]s-generic"
+  server_url            = "https://some-server.example.com"
+}
+
+data "azuredevops_serviceendpoints" "test" {
+  project_id = azuredevops_project.test.id
+  type       = "externalnpmregistry"
+  ready_only = true
+
+  depends_on = [azuredevops_serviceendpoint_npm.test, azuredevops_serviceendpoint_generic.test]
+}
+`, name)
+}
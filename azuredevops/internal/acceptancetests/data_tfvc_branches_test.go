@@ -0,0 +1,45 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccDataTfvcBranches_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_tfvc_branches.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDataTfvcBranches(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttr(tfNode, "include_deleted", "false"),
+					resource.TestCheckResourceAttr(tfNode, "branches.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func hclDataTfvcBranches(projectName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%s"
+  description        = "description"
+  visibility         = "private"
+  version_control    = "Tfvc"
+  work_item_template = "Agile"
+}
+
+data "azuredevops_tfvc_branches" "test" {
+  project_id = azuredevops_project.test.id
+}`, projectName)
+}
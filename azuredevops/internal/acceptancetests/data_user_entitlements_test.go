@@ -0,0 +1,58 @@
+package acceptancetests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccUserEntitlements_DataSource(t *testing.T) {
+	tfNode := "data.azuredevops_user_entitlements.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclDataUserEntitlementsBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttrSet(tfNode, "users.0.id"),
+					resource.TestCheckResourceAttrSet(tfNode, "users.0.principal_name"),
+					resource.TestCheckResourceAttrSet(tfNode, "users.0.account_license_type"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserEntitlements_DataSource_InactiveFilter(t *testing.T) {
+	tfNode := "data.azuredevops_user_entitlements.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclDataUserEntitlementsInactive(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttrSet(tfNode, "users.#"),
+				),
+			},
+		},
+	})
+}
+
+func hclDataUserEntitlementsBasic() string {
+	return `
+data "azuredevops_user_entitlements" "test" {
+}`
+}
+
+func hclDataUserEntitlementsInactive() string {
+	return `
+data "azuredevops_user_entitlements" "test" {
+  license_type        = "basic"
+  inactive_since_days = 30
+}`
+}
@@ -0,0 +1,87 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccAgentPoolPermissions_SetPermissions(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	poolName := testutils.GenerateResourceName()
+	tfNodePool := "azuredevops_agent_pool_permissions.pool-permissions"
+	tfNodeQueue := "azuredevops_agent_pool_permissions.queue-permissions"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclAgentPoolPermissions(projectName, poolName),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckProjectExists(projectName),
+					resource.TestCheckResourceAttrSet(tfNodePool, "agent_pool_id"),
+					resource.TestCheckNoResourceAttr(tfNodePool, "project_id"),
+					resource.TestCheckResourceAttr(tfNodePool, "permissions.%", "2"),
+					resource.TestCheckResourceAttr(tfNodePool, "permissions.View", "allow"),
+					resource.TestCheckResourceAttr(tfNodePool, "permissions.Manage", "deny"),
+					resource.TestCheckResourceAttrSet(tfNodeQueue, "project_id"),
+					resource.TestCheckResourceAttrSet(tfNodeQueue, "agent_queue_id"),
+					resource.TestCheckResourceAttr(tfNodeQueue, "permissions.%", "2"),
+					resource.TestCheckResourceAttr(tfNodeQueue, "permissions.View", "allow"),
+					resource.TestCheckResourceAttr(tfNodeQueue, "permissions.Use", "allow"),
+				),
+			},
+		},
+	})
+}
+
+func hclAgentPoolPermissions(projectName string, poolName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "project" {
+  name               = "%s"
+  description        = "description"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+data "azuredevops_group" "tf-project-readers" {
+  project_id = azuredevops_project.project.id
+  name       = "Readers"
+}
+
+resource "azuredevops_agent_pool" "pool" {
+  name           = "%s"
+  auto_provision = false
+  auto_update    = false
+}
+
+resource "azuredevops_agent_queue" "queue" {
+  project_id    = azuredevops_project.project.id
+  agent_pool_id = azuredevops_agent_pool.pool.id
+}
+
+resource "azuredevops_agent_pool_permissions" "pool-permissions" {
+  agent_pool_id = azuredevops_agent_pool.pool.id
+  principal     = data.azuredevops_group.tf-project-readers.id
+  permissions = {
+    View   = "allow"
+    Manage = "deny"
+  }
+}
+
+resource "azuredevops_agent_pool_permissions" "queue-permissions" {
+  project_id     = azuredevops_project.project.id
+  agent_queue_id = azuredevops_agent_queue.queue.id
+  principal      = data.azuredevops_group.tf-project-readers.id
+  permissions = {
+    View = "allow"
+    Use  = "allow"
+  }
+}
+`, projectName, poolName)
+}
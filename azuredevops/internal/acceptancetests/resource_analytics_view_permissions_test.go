@@ -0,0 +1,59 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccAnalyticsViewPermissions_SetPermissions(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_analytics_view_permissions.permissions"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclAnalyticsViewPermissions(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckProjectExists(projectName),
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "principal"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.%", "2"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Read", "allow"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Edit", "deny"),
+				),
+			},
+		},
+	})
+}
+
+func hclAnalyticsViewPermissions(projectName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "project" {
+  name               = "%s"
+  description        = "description"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+data "azuredevops_group" "tf-project-readers" {
+  project_id = azuredevops_project.project.id
+  name       = "Readers"
+}
+
+resource "azuredevops_analytics_view_permissions" "permissions" {
+  project_id = azuredevops_project.project.id
+  principal  = data.azuredevops_group.tf-project-readers.id
+  permissions = {
+    Read = "allow"
+    Edit = "deny"
+  }
+}
+`, projectName)
+}
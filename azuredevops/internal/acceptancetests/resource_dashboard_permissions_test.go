@@ -0,0 +1,67 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccDashboardPermissions_SetPermissions(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_dashboard_permissions.permissions"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDashboardPermissions(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckProjectExists(projectName),
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "principal"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.%", "3"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Read", "allow"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Edit", "deny"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Delete", "deny"),
+				),
+			},
+		},
+	})
+}
+
+func hclDashboardPermissions(projectName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "project" {
+  name               = "%s"
+  description        = "description"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+data "azuredevops_group" "tf-project-readers" {
+  project_id = azuredevops_project.project.id
+  name       = "Readers"
+}
+
+data "azuredevops_team" "team" {
+  project_id = azuredevops_project.project.id
+  name       = "${azuredevops_project.project.name} Team"
+}
+
+resource "azuredevops_dashboard_permissions" "permissions" {
+  project_id = azuredevops_project.project.id
+  team_id    = data.azuredevops_team.team.id
+  principal  = data.azuredevops_group.tf-project-readers.id
+  permissions = {
+    Read   = "allow"
+    Edit   = "deny"
+    Delete = "deny"
+  }
+}
+`, projectName)
+}
@@ -0,0 +1,95 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/datahelper"
+)
+
+func TestAccEnvironmentPermissions_SetPermissions(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	environmentName := testutils.GenerateResourceName()
+	config := hclEnvironmentPermissions(projectName, environmentName, map[string]map[string]string{
+		"root": {
+			"View":   "allow",
+			"Create": "deny",
+		},
+		"environment": {
+			"View":       "allow",
+			"Use":        "allow",
+			"Administer": "deny",
+		},
+	})
+	tfNodeRoot := "azuredevops_environment_permissions.root-permissions"
+	tfNodeEnvironment := "azuredevops_environment_permissions.environment-permissions"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckProjectExists(projectName),
+					resource.TestCheckResourceAttrSet(tfNodeRoot, "project_id"),
+					resource.TestCheckResourceAttrSet(tfNodeRoot, "principal"),
+					resource.TestCheckNoResourceAttr(tfNodeRoot, "environment_id"),
+					resource.TestCheckResourceAttr(tfNodeRoot, "permissions.%", "2"),
+					resource.TestCheckResourceAttr(tfNodeRoot, "permissions.View", "allow"),
+					resource.TestCheckResourceAttr(tfNodeRoot, "permissions.Create", "deny"),
+					resource.TestCheckResourceAttrSet(tfNodeEnvironment, "environment_id"),
+					resource.TestCheckResourceAttr(tfNodeEnvironment, "permissions.%", "3"),
+					resource.TestCheckResourceAttr(tfNodeEnvironment, "permissions.View", "allow"),
+					resource.TestCheckResourceAttr(tfNodeEnvironment, "permissions.Use", "allow"),
+					resource.TestCheckResourceAttr(tfNodeEnvironment, "permissions.Administer", "deny"),
+				),
+			},
+		},
+	})
+}
+
+func hclEnvironmentPermissions(projectName string, environmentName string, permissions map[string]map[string]string) string {
+	rootPermissions := datahelper.JoinMap(permissions["root"], "=", "\n")
+	environmentPermissions := datahelper.JoinMap(permissions["environment"], "=", "\n")
+
+	return fmt.Sprintf(`
+resource "azuredevops_project" "project" {
+  name               = "%s"
+  description        = "description"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+data "azuredevops_group" "tf-project-readers" {
+  project_id = azuredevops_project.project.id
+  name       = "Readers"
+}
+
+resource "azuredevops_environment" "environment" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+}
+
+resource "azuredevops_environment_permissions" "root-permissions" {
+  project_id = azuredevops_project.project.id
+  principal  = data.azuredevops_group.tf-project-readers.id
+  permissions = {
+		%s
+  }
+}
+
+resource "azuredevops_environment_permissions" "environment-permissions" {
+  project_id     = azuredevops_project.project.id
+  environment_id = azuredevops_environment.environment.id
+  principal      = data.azuredevops_group.tf-project-readers.id
+  permissions = {
+		%s
+  }
+}
+`, projectName, environmentName, rootPermissions, environmentPermissions)
+}
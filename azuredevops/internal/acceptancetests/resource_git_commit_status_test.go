@@ -0,0 +1,110 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccGitCommitStatus_commit(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	resNode := "azuredevops_git_commit_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitCommitStatusCommit(projectName, gitRepoName, "pending"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resNode, "state", "pending"),
+					resource.TestCheckResourceAttr(resNode, "genre", "deployment"),
+					resource.TestCheckResourceAttr(resNode, "name", "production"),
+					resource.TestCheckResourceAttrSet(resNode, "status_id"),
+					resource.TestCheckResourceAttrSet(resNode, "created_by"),
+				),
+			},
+			{
+				Config: hclGitCommitStatusCommit(projectName, gitRepoName, "succeeded"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resNode, "state", "succeeded"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitCommitStatus_pullRequest(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	branchName := testutils.GenerateResourceName()
+	resNode := "azuredevops_git_commit_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitCommitStatusPullRequest(projectName, gitRepoName, branchName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resNode, "state", "succeeded"),
+					resource.TestCheckResourceAttrSet(resNode, "pull_request_id"),
+					resource.TestCheckResourceAttrSet(resNode, "status_id"),
+				),
+			},
+		},
+	})
+}
+
+func hclGitCommitStatusCommit(projectName, gitRepoName, state string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%[1]s"
+  description        = "description"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[2]s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+data "azuredevops_git_repository" "test" {
+  project_id       = azuredevops_project.test.id
+  name             = azuredevops_git_repository.test.name
+  include_branches = true
+}
+
+resource "azuredevops_git_commit_status" "test" {
+  repository_id = azuredevops_git_repository.test.id
+  commit_id     = data.azuredevops_git_repository.test.branches[0].commit_id
+  genre         = "deployment"
+  name          = "production"
+  state         = "%[3]s"
+  description   = "Deployment to production"
+  target_url    = "https://example.com/deployments"
+}`, projectName, gitRepoName, state)
+}
+
+func hclGitCommitStatusPullRequest(projectName, gitRepoName, branchName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_commit_status" "test" {
+  repository_id   = azuredevops_git_repository.test.id
+  pull_request_id = azuredevops_git_pull_request.test.pull_request_id
+  genre           = "deployment"
+  name            = "production"
+  state           = "succeeded"
+}`, hclGitPullRequest(projectName, gitRepoName, branchName, "Status check", false))
+}
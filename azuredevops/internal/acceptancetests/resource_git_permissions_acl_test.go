@@ -0,0 +1,93 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccGitPermissionsAcl_repository(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_git_permissions_acl.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitPermissionsAcl(projectName, gitRepoName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "repository_id"),
+					resource.TestCheckResourceAttr(tfNode, "permission.#", "2"),
+				),
+			},
+			{
+				Config: hclGitPermissionsAcl(projectName, gitRepoName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "permission.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func hclGitPermissionsAcl(projectName, gitRepoName string, withContributors bool) string {
+	contributors := ""
+	if withContributors {
+		contributors = `
+  permission {
+    principal = data.azuredevops_group.contributors.id
+    permissions = {
+      GenericRead       = "Allow"
+      GenericContribute = "Allow"
+      ForcePush         = "Deny"
+    }
+  }`
+	}
+
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%[1]s"
+  description        = "description"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[2]s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+data "azuredevops_group" "readers" {
+  project_id = azuredevops_project.test.id
+  name       = "Readers"
+}
+
+data "azuredevops_group" "contributors" {
+  project_id = azuredevops_project.test.id
+  name       = "Contributors"
+}
+
+resource "azuredevops_git_permissions_acl" "test" {
+  project_id    = azuredevops_project.test.id
+  repository_id = azuredevops_git_repository.test.id
+
+  permission {
+    principal = data.azuredevops_group.readers.id
+    permissions = {
+      GenericRead       = "Allow"
+      GenericContribute = "Deny"
+    }
+  }
+%[3]s
+}`, projectName, gitRepoName, contributors)
+}
@@ -0,0 +1,145 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccGitPullRequest_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	branchName := testutils.GenerateResourceName()
+	resNode := "azuredevops_git_pull_request.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitPullRequest(projectName, gitRepoName, branchName, "Initial title", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resNode, "title", "Initial title"),
+					resource.TestCheckResourceAttr(resNode, "source_branch", branchName),
+					resource.TestCheckResourceAttr(resNode, "target_branch", "master"),
+					resource.TestCheckResourceAttr(resNode, "is_draft", "true"),
+					resource.TestCheckResourceAttr(resNode, "status", "active"),
+					resource.TestCheckResourceAttrSet(resNode, "pull_request_id"),
+					resource.TestCheckResourceAttrSet(resNode, "created_by"),
+				),
+			},
+			{
+				Config: hclGitPullRequest(projectName, gitRepoName, branchName, "Updated title", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resNode, "title", "Updated title"),
+					resource.TestCheckResourceAttr(resNode, "is_draft", "false"),
+					resource.TestCheckResourceAttr(resNode, "status", "active"),
+				),
+			},
+			{
+				ResourceName:            resNode,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"completion_options"},
+			},
+		},
+	})
+}
+
+func TestAccGitPullRequest_autoComplete(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	branchName := testutils.GenerateResourceName()
+	resNode := "azuredevops_git_pull_request.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitPullRequestAutoComplete(projectName, gitRepoName, branchName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resNode, "auto_complete", "true"),
+					resource.TestCheckResourceAttr(resNode, "completion_options.#", "1"),
+					resource.TestCheckResourceAttr(resNode, "completion_options.0.merge_strategy", "squash"),
+					resource.TestCheckResourceAttr(resNode, "completion_options.0.delete_source_branch", "true"),
+				),
+			},
+		},
+	})
+}
+
+func hclGitPullRequestTemplate(projectName, gitRepoName, branchName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%[1]s"
+  description        = "description"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[2]s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_git_repository_branch" "test" {
+  repository_id = azuredevops_git_repository.test.id
+  name          = "%[3]s"
+  ref_branch    = "master"
+}
+
+resource "azuredevops_git_repository_file" "test" {
+  repository_id       = azuredevops_git_repository.test.id
+  file                = "foo.txt"
+  content             = "bar"
+  branch              = "refs/heads/${azuredevops_git_repository_branch.test.name}"
+  commit_message      = "Add foo.txt"
+  overwrite_on_create = false
+}`, projectName, gitRepoName, branchName)
+}
+
+func hclGitPullRequest(projectName, gitRepoName, branchName, title string, isDraft bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_pull_request" "test" {
+  repository_id = azuredevops_git_repository.test.id
+  source_branch = azuredevops_git_repository_branch.test.name
+  target_branch = "master"
+  title         = "%s"
+  description   = "Created by Terraform"
+  is_draft      = %t
+
+  depends_on = [azuredevops_git_repository_file.test]
+}`, hclGitPullRequestTemplate(projectName, gitRepoName, branchName), title, isDraft)
+}
+
+func hclGitPullRequestAutoComplete(projectName, gitRepoName, branchName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_pull_request" "test" {
+  repository_id = azuredevops_git_repository.test.id
+  source_branch = azuredevops_git_repository_branch.test.name
+  target_branch = "master"
+  title         = "Auto complete"
+  is_draft      = true
+  auto_complete = true
+
+  completion_options {
+    merge_strategy       = "squash"
+    delete_source_branch = true
+  }
+
+  depends_on = [azuredevops_git_repository_file.test]
+}`, hclGitPullRequestTemplate(projectName, gitRepoName, branchName))
+}
@@ -32,6 +32,34 @@ func TestAccGroupEntitlement_Create(t *testing.T) {
 	})
 }
 
+func TestAccGroupEntitlement_ProjectEntitlement(t *testing.T) {
+	tfNode := "azuredevops_group_entitlement.test"
+	projectName := testutils.GenerateResourceName()
+	displayName := testutils.GenerateResourceName()
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkGroupEntitlementDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGroupEntitlementResourceProjectEntitlement(projectName, displayName, "projectReader"),
+				Check: resource.ComposeTestCheckFunc(
+					checkGroupEntitlementExists(),
+					resource.TestCheckResourceAttr(tfNode, "project_entitlement.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "project_entitlement.0.group_type", "projectReader"),
+				),
+			},
+			{
+				Config: hclGroupEntitlementResourceProjectEntitlement(projectName, displayName, "projectContributor"),
+				Check: resource.ComposeTestCheckFunc(
+					checkGroupEntitlementExists(),
+					resource.TestCheckResourceAttr(tfNode, "project_entitlement.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "project_entitlement.0.group_type", "projectContributor"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGroupEntitlement_AAD_Create(t *testing.T) {
 	if os.Getenv("AZDO_TEST_AAD_GROUP_ID") == "" {
 		t.Skip("Skip test dueto `AZDO_TEST_AAD_GROUP_ID` not set")
@@ -135,3 +163,20 @@ resource "azuredevops_group_entitlement" "test" {
   account_license_type = "express"
 }`, originId)
 }
+
+func hclGroupEntitlementResourceProjectEntitlement(projectName string, displayName string, groupType string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "project" {
+  name = "%s"
+}
+
+resource "azuredevops_group_entitlement" "test" {
+  display_name         = "%s"
+  account_license_type = "express"
+
+  project_entitlement {
+    project_id = azuredevops_project.project.id
+    group_type = "%s"
+  }
+}`, projectName, displayName, groupType)
+}
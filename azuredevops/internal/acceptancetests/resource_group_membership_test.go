@@ -2,6 +2,7 @@ package acceptancetests
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -44,6 +45,29 @@ func TestAccGroupMembership_overwrite(t *testing.T) {
 	})
 }
 
+func TestAccGroupMembership_expandNested(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_group_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: expandNested(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "members.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "transitive_members.#", "2"),
+				),
+			},
+			{
+				Config:      membershipCycle(projectName),
+				ExpectError: regexp.MustCompile(`would create a membership cycle`),
+			},
+		},
+	})
+}
+
 func overwriteEmpty(name string) string {
 	return fmt.Sprintf(`
 resource "azuredevops_project" "test" {
@@ -111,3 +135,42 @@ resource "azuredevops_group_membership" "test" {
 }
 `, name)
 }
+
+func expandNested(name string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name = "acctest-%[1]s"
+}
+
+resource "azuredevops_group" "nested" {
+  display_name = "acctest-nested-%[1]s"
+  scope        = azuredevops_project.test.id
+}
+
+resource "azuredevops_group" "member" {
+  display_name = "acctest-member-%[1]s"
+  scope        = azuredevops_project.test.id
+  members      = [azuredevops_group.nested.id]
+}
+
+resource "azuredevops_group" "test" {
+  display_name = "acctest-%[1]s"
+  scope        = azuredevops_project.test.id
+}
+
+resource "azuredevops_group_membership" "test" {
+  group         = azuredevops_group.test.id
+  members       = [azuredevops_group.member.id]
+  expand_nested = true
+}
+`, name)
+}
+
+func membershipCycle(name string) string {
+	return expandNested(name) + `
+resource "azuredevops_group_membership" "cycle" {
+  group   = azuredevops_group.nested.id
+  members = [azuredevops_group.test.id]
+}
+`
+}
@@ -64,6 +64,48 @@ func TestAccServiceEndpointKubernetes_azureSubscription(t *testing.T) {
 	})
 }
 
+func TestAccServiceEndpointKubernetes_workloadIdentity(t *testing.T) {
+	if os.Getenv("KUBE_ARM_SUBSCRIPTION_ID") == "" || os.Getenv("KUBE_ARM_SUBSCRIPTION_NAME") == "" ||
+		os.Getenv("KUBE_ARM_TENANT_ID") == "" || os.Getenv("KUBE_ARM_RESOURCE_GROUP") == "" ||
+		os.Getenv("KUBE_ARM_KUBE_NAMESPACE") == "" || os.Getenv("KUBE_ARM_KUBE_CLUSTER_NAME") == "" ||
+		os.Getenv("KUBE_ARM_KUBE_API_URL") == "" {
+		t.Skip("Skipping tests due to missing Kubernetes Resource")
+	}
+
+	projectName := testutils.GenerateResourceName()
+	serviceEndpointName := testutils.GenerateResourceName()
+
+	tfSvcEpNode := "azuredevops_serviceendpoint_kubernetes.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.PreCheck(t, &[]string{
+				"KUBE_ARM_SUBSCRIPTION_ID",
+				"KUBE_ARM_SUBSCRIPTION_NAME",
+				"KUBE_ARM_TENANT_ID",
+				"KUBE_ARM_RESOURCE_GROUP",
+				"KUBE_ARM_KUBE_API_URL",
+				"KUBE_ARM_KUBE_NAMESPACE",
+				"KUBE_ARM_KUBE_CLUSTER_NAME",
+			})
+		},
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkSvcEndpointKubernetesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclServiceEndpointKubernetesWorkloadIdentityResource(projectName, serviceEndpointName),
+				Check: resource.ComposeTestCheckFunc(
+					checkSvcEndpointKubernetesExists(serviceEndpointName),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "authorization_type", "WorkloadIdentityFederation"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "azure_workload_identity.0.create_service_account", "false"),
+					resource.TestCheckResourceAttrPair(tfSvcEpNode, "azure_workload_identity.0.azurerm_service_endpoint_id", "azuredevops_serviceendpoint_azurerm.test", "id"),
+					resource.TestCheckResourceAttr("azuredevops_environment_resource_kubernetes.test", "namespace", os.Getenv("KUBE_ARM_KUBE_NAMESPACE")),
+					resource.TestCheckResourceAttr("azuredevops_environment_resource_kubernetes.test", "cluster_name", os.Getenv("KUBE_ARM_KUBE_CLUSTER_NAME")),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServiceEndpointKubernetes_serviceAccount(t *testing.T) {
 	projectName := testutils.GenerateResourceName()
 	serviceEndpointNameFirst := testutils.GenerateResourceName()
@@ -211,6 +253,58 @@ resource "azuredevops_serviceendpoint_kubernetes" "test" {
 		os.Getenv("KUBE_ARM_KUBE_CLUSTER_NAME"))
 }
 
+func hclServiceEndpointKubernetesWorkloadIdentityResource(projectName, serviceEndpointName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name = "%[1]s"
+}
+
+resource "azuredevops_serviceendpoint_azurerm" "test" {
+  project_id                             = azuredevops_project.test.id
+  service_endpoint_name                  = "%[2]s-azurerm"
+  service_endpoint_authentication_scheme = "WorkloadIdentityFederation"
+  azurerm_spn_tenantid                   = "%[6]s"
+  azurerm_subscription_id                = "%[4]s"
+  azurerm_subscription_name              = "%[5]s"
+}
+
+resource "azuredevops_serviceendpoint_kubernetes" "test" {
+  project_id            = azuredevops_project.test.id
+  service_endpoint_name = "%[2]s"
+  authorization_type    = "WorkloadIdentityFederation"
+  apiserver_url         = "%[3]s"
+  azure_workload_identity {
+    azurerm_service_endpoint_id = azuredevops_serviceendpoint_azurerm.test.id
+    subscription_id             = "%[4]s"
+    subscription_name           = "%[5]s"
+    resourcegroup_id            = "%[7]s"
+    namespace                   = "%[8]s"
+    cluster_name                = "%[9]s"
+    create_service_account      = false
+  }
+}
+
+resource "azuredevops_environment" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[2]s"
+}
+
+resource "azuredevops_environment_resource_kubernetes" "test" {
+  project_id          = azuredevops_project.test.id
+  environment_id      = azuredevops_environment.test.id
+  service_endpoint_id = azuredevops_serviceendpoint_kubernetes.test.id
+  name                = "%[2]s"
+}
+`, projectName, serviceEndpointName,
+		os.Getenv("KUBE_ARM_KUBE_API_URL"),
+		os.Getenv("KUBE_ARM_SUBSCRIPTION_ID"),
+		os.Getenv("KUBE_ARM_SUBSCRIPTION_NAME"),
+		os.Getenv("KUBE_ARM_TENANT_ID"),
+		os.Getenv("KUBE_ARM_RESOURCE_GROUP"),
+		os.Getenv("KUBE_ARM_KUBE_NAMESPACE"),
+		os.Getenv("KUBE_ARM_KUBE_CLUSTER_NAME"))
+}
+
 func hclServiceEndpointKubernetesServiceAccount(projectName, serviceEndpointName string) string {
 	return fmt.Sprintf(`
 resource "azuredevops_project" "test" {
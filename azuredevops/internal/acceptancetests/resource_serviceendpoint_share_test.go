@@ -0,0 +1,55 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServiceEndpointShare_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	targetProjectName := testutils.GenerateResourceName()
+	serviceEndpointName := testutils.GenerateResourceName()
+	sharedName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_serviceendpoint_share.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclServiceEndpointShare(projectName, targetProjectName, serviceEndpointName, sharedName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "service_endpoint_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "target_project_id"),
+					resource.TestCheckResourceAttr(tfNode, "name", sharedName),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func hclServiceEndpointShare(projectName, targetProjectName, serviceEndpointName, sharedName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_project" "target" {
+  name = "%s"
+}
+
+resource "azuredevops_serviceendpoint_share" "test" {
+  project_id          = azuredevops_project.project.id
+  service_endpoint_id = azuredevops_serviceendpoint_github.serviceendpoint.id
+  target_project_id   = azuredevops_project.target.id
+  name                = "%s"
+}
+`, testutils.HclServiceEndpointGitHubResource(projectName, serviceEndpointName), targetProjectName, sharedName)
+}
@@ -0,0 +1,114 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/datahelper"
+)
+
+func TestAccTfvcPermissions_SetPermissions(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	config := hclTfvcPermissions(projectName, "", map[string]string{
+		"Read":       "allow",
+		"PendChange": "deny",
+		"Checkin":    "notset",
+	})
+	tfNode := "azuredevops_tfvc_permissions.permissions"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckProjectExists(projectName),
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "principal"),
+					resource.TestCheckNoResourceAttr(tfNode, "path"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.%", "3"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Read", "allow"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.PendChange", "deny"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Checkin", "notset"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTfvcPermissions_UpdatePermissions(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	config1 := hclTfvcPermissions(projectName, "BuildProcessTemplates", map[string]string{
+		"Read":    "allow",
+		"Checkin": "deny",
+	})
+	config2 := hclTfvcPermissions(projectName, "BuildProcessTemplates", map[string]string{
+		"Read":    "allow",
+		"Checkin": "notset",
+	})
+	tfNode := "azuredevops_tfvc_permissions.permissions"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckProjectExists(projectName),
+					resource.TestCheckResourceAttr(tfNode, "path", "BuildProcessTemplates"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.%", "2"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Read", "allow"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Checkin", "deny"),
+				),
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckProjectExists(projectName),
+					resource.TestCheckResourceAttr(tfNode, "path", "BuildProcessTemplates"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.%", "2"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Read", "allow"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Checkin", "notset"),
+				),
+			},
+		},
+	})
+}
+
+func hclTfvcPermissions(projectName string, path string, permissions map[string]string) string {
+	tfvcPermissions := datahelper.JoinMap(permissions, "=", "\n")
+	pathArg := ""
+	if path != "" {
+		pathArg = fmt.Sprintf("path       = %q", path)
+	}
+
+	return fmt.Sprintf(`
+resource "azuredevops_project" "project" {
+  name               = "%s"
+  description        = "description"
+  visibility         = "private"
+  version_control    = "Tfvc"
+  work_item_template = "Agile"
+}
+
+data "azuredevops_group" "tf-project-readers" {
+  project_id = azuredevops_project.project.id
+  name       = "Readers"
+}
+
+resource "azuredevops_tfvc_permissions" "permissions" {
+  project_id = azuredevops_project.project.id
+  %s
+  principal  = data.azuredevops_group.tf-project-readers.id
+  permissions = {
+		%s
+  }
+}
+`, projectName, pathArg, tfvcPermissions)
+}
@@ -0,0 +1,63 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccUserEntitlements_CreateUpdate(t *testing.T) {
+	if os.Getenv("AZDO_TEST_AAD_USER_EMAIL") == "" {
+		t.Skip("Skip test due to `AZDO_TEST_AAD_USER_EMAIL` not set")
+	}
+	tfNode := "azuredevops_user_entitlements.users"
+	principalName := os.Getenv("AZDO_TEST_AAD_USER_EMAIL")
+	projectName := testutils.GenerateResourceName()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, &[]string{"AZDO_TEST_AAD_USER_EMAIL"}) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclUserEntitlementsResource(projectName, principalName, "express", "projectContributor"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "user.#", "1"),
+					resource.TestCheckResourceAttrSet(tfNode, "user_ids."+principalName),
+					resource.TestCheckResourceAttr(tfNode, "changed_users.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "failed_users.#", "0"),
+					resource.TestCheckResourceAttr(tfNode, "throttled_users.#", "0"),
+				),
+			},
+			{
+				Config: hclUserEntitlementsResource(projectName, principalName, "stakeholder", "projectReader"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "user.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "user.0.account_license_type", "stakeholder"),
+					resource.TestCheckResourceAttr(tfNode, "user.0.project_entitlement.0.group_type", "projectReader"),
+					resource.TestCheckResourceAttr(tfNode, "failed_users.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func hclUserEntitlementsResource(projectName string, principalName string, accountLicenseType string, groupType string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "project" {
+  name = "%s"
+}
+
+resource "azuredevops_user_entitlements" "users" {
+  user {
+    principal_name       = "%s"
+    account_license_type = "%s"
+
+    project_entitlement {
+      project_id = azuredevops_project.project.id
+      group_type = "%s"
+    }
+  }
+}`, projectName, principalName, accountLicenseType, groupType)
+}
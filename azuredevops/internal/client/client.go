@@ -29,11 +29,13 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/tfvc"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/wiki"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtrackingprocess"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/dashboardextras"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/memberentitlementmanagementextras"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/organization"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/pipelineschecksextras"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/securityroles"
@@ -67,7 +69,9 @@ type AggregatedClient struct {
 	ReleaseClient                 release.Client
 	ServiceEndpointClient         serviceendpoint.Client
 	TaskAgentClient               taskagent.Client
+	TfvcClient                    tfvc.Client
 	MemberEntitleManagementClient memberentitlementmanagement.Client
+	MemberEntitleManagementExtras memberentitlementmanagementextras.Client
 	FeatureManagementClient       featuremanagement.Client
 	FeedClient                    feed.Client
 	SecurityClient                security.Client
@@ -163,6 +167,12 @@ func GetAzdoClient(authProvider azuredevops.AuthProvider, organizationURL string
 		return nil, err
 	}
 
+	memberentitlementmanagementExtrasClient, err := memberentitlementmanagementextras.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): memberentitlementmanagementextras.NewClient failed.")
+		return nil, err
+	}
+
 	policyClient, err := policy.NewClient(ctx, connection)
 	if err != nil {
 		log.Printf("getAzdoClient(): policy.NewClient failed.")
@@ -188,6 +198,12 @@ func GetAzdoClient(authProvider azuredevops.AuthProvider, organizationURL string
 		return nil, err
 	}
 
+	tfvcClient, err := tfvc.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): tfvc.NewClient failed.")
+		return nil, err
+	}
+
 	featuremanagementClient := featuremanagement.NewClient(ctx, connection)
 
 	feedClient, err := feed.NewClient(ctx, connection)
@@ -258,7 +274,9 @@ func GetAzdoClient(authProvider azuredevops.AuthProvider, organizationURL string
 		ReleaseClient:                 releaseClient,
 		ServiceEndpointClient:         serviceEndpointClient,
 		TaskAgentClient:               taskagentClient,
+		TfvcClient:                    tfvcClient,
 		MemberEntitleManagementClient: memberentitlementmanagementClient,
+		MemberEntitleManagementExtras: memberentitlementmanagementExtrasClient,
 		FeatureManagementClient:       featuremanagementClient,
 		FeedClient:                    feedClient,
 		SecurityClient:                securityClient,
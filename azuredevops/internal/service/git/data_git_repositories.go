@@ -42,6 +42,11 @@ func DataGitRepositories() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"include_branches": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"repositories": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -87,6 +92,7 @@ func DataGitRepositories() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"branches": dataGitRepositoryBranchesSchema(),
 					},
 				},
 			},
@@ -110,6 +116,15 @@ func dataSourceGitRepositoriesRead(d *schema.ResourceData, m interface{}) error
 	}
 
 	results := flattenGitRepositories(projectRepos)
+	if d.Get("include_branches").(bool) && projectRepos != nil {
+		for i, repo := range *projectRepos {
+			branches, err := getGitRepositoryBranches(clients, &repo)
+			if err != nil {
+				return err
+			}
+			results[i].(map[string]interface{})["branches"] = branches
+		}
+	}
 	repoNames, err := datahelper.GetAttributeValues(results, "name")
 	if err != nil {
 		return fmt.Errorf("failed to get list of repository names: %v", err)
@@ -2,12 +2,15 @@ package git
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
 )
 
@@ -63,6 +66,12 @@ func DataGitRepository() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"include_branches": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"branches": dataGitRepositoryBranchesSchema(),
 		},
 	}
 }
@@ -94,5 +103,124 @@ func dataSourceGitRepositoryRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return fmt.Errorf("flattening Git repository: %w", err)
 	}
+
+	if d.Get("include_branches").(bool) {
+		branches, err := getGitRepositoryBranches(clients, &repo)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("branches", branches); err != nil {
+			return fmt.Errorf("setting branches: %+v", err)
+		}
+	}
 	return nil
 }
+
+func dataGitRepositoryBranchesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"is_default": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"commit_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"commit_author": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"commit_date": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"commit_comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"ahead_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"behind_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"has_policies": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// getGitRepositoryBranches returns the branches of a repository with their head commit, the ahead/behind
+// counts compared to the default branch and whether any branch policy applies to them.
+func getGitRepositoryBranches(clients *client.AggregatedClient, repo *git.GitRepository) ([]interface{}, error) {
+	results := []interface{}{}
+	// Branches cannot be listed for an uninitialized or disabled repository
+	if converter.ToString(repo.DefaultBranch, "") == "" || converter.ToBool(repo.IsDisabled, false) {
+		return results, nil
+	}
+
+	repoId := repo.Id.String()
+	branches, err := clients.GitReposClient.GetBranches(clients.Ctx, git.GetBranchesArgs{
+		RepositoryId: converter.String(repoId),
+		Project:      converter.String(repo.Project.Id.String()),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return results, nil
+		}
+		return nil, fmt.Errorf("listing branches of repository %s: %+v", repoId, err)
+	}
+	if branches == nil {
+		return results, nil
+	}
+
+	for _, branch := range *branches {
+		if branch.Name == nil {
+			continue
+		}
+		refName := withPrefix(REF_BRANCH_PREFIX, *branch.Name)
+
+		policies, err := clients.GitReposClient.GetPolicyConfigurations(clients.Ctx, git.GetPolicyConfigurationsArgs{
+			Project:      converter.String(repo.Project.Id.String()),
+			RepositoryId: repo.Id,
+			RefName:      converter.String(refName),
+			Top:          converter.Int(1),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing policies of branch %s in repository %s: %+v", *branch.Name, repoId, err)
+		}
+
+		result := map[string]interface{}{
+			"name":         strings.TrimPrefix(*branch.Name, REF_BRANCH_PREFIX),
+			"is_default":   strings.EqualFold(refName, withPrefix(REF_BRANCH_PREFIX, *repo.DefaultBranch)),
+			"ahead_count":  converter.ToInt(branch.AheadCount, 0),
+			"behind_count": converter.ToInt(branch.BehindCount, 0),
+			"has_policies": policies != nil && policies.PolicyConfigurations != nil && len(*policies.PolicyConfigurations) > 0,
+		}
+		if commit := branch.Commit; commit != nil {
+			result["commit_id"] = converter.ToString(commit.CommitId, "")
+			result["commit_comment"] = converter.ToString(commit.Comment, "")
+			if commit.Author != nil {
+				result["commit_author"] = converter.ToString(commit.Author.Email, "")
+				if commit.Author.Date != nil {
+					result["commit_date"] = commit.Author.Date.Time.Format(time.RFC3339)
+				}
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
@@ -127,3 +128,80 @@ func TestGitRepositoryDataSource_Read_RepositoryNotFoundError(t *testing.T) {
 	err := dataSourceGitRepositoryRead(resourceData, clients)
 	require.NotNil(t, err)
 }
+
+func TestGitRepositoryDataSource_Read_IncludeBranches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoClient := azdosdkmocks.NewMockGitClient(ctrl)
+
+	clients := &client.AggregatedClient{
+		GitReposClient: repoClient,
+		Ctx:            context.Background(),
+	}
+
+	repoClient.
+		EXPECT().
+		GetRepository(clients.Ctx, gomock.Any()).
+		Return(&gitRepo, nil)
+
+	repoClient.
+		EXPECT().
+		GetBranches(clients.Ctx, git.GetBranchesArgs{
+			RepositoryId: converter.String(gitRepo.Id.String()),
+			Project:      converter.String(gitRepo.Project.Id.String()),
+		}).
+		Return(&[]git.GitBranchStats{
+			{
+				Name:        converter.String("master"),
+				AheadCount:  converter.Int(0),
+				BehindCount: converter.Int(0),
+				Commit:      &git.GitCommitRef{CommitId: converter.String("commit-1")},
+			},
+			{
+				Name:        converter.String("feature"),
+				AheadCount:  converter.Int(2),
+				BehindCount: converter.Int(1),
+				Commit:      &git.GitCommitRef{CommitId: converter.String("commit-2")},
+			},
+		}, nil)
+
+	repoClient.
+		EXPECT().
+		GetPolicyConfigurations(clients.Ctx, git.GetPolicyConfigurationsArgs{
+			Project:      converter.String(gitRepo.Project.Id.String()),
+			RepositoryId: gitRepo.Id,
+			RefName:      converter.String("refs/heads/master"),
+			Top:          converter.Int(1),
+		}).
+		Return(&git.GitPolicyConfigurationResponse{
+			PolicyConfigurations: &[]policy.PolicyConfiguration{{Id: converter.Int(1)}},
+		}, nil)
+
+	repoClient.
+		EXPECT().
+		GetPolicyConfigurations(clients.Ctx, git.GetPolicyConfigurationsArgs{
+			Project:      converter.String(gitRepo.Project.Id.String()),
+			RepositoryId: gitRepo.Id,
+			RefName:      converter.String("refs/heads/feature"),
+			Top:          converter.Int(1),
+		}).
+		Return(&git.GitPolicyConfigurationResponse{}, nil)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGitRepository().Schema, nil)
+	resourceData.Set("name", gitRepo.Name)
+	resourceData.Set("project_id", gitRepo.Project.Id.String())
+	resourceData.Set("include_branches", true)
+
+	err := dataSourceGitRepositoryRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, 2, resourceData.Get("branches.#"))
+	require.Equal(t, "master", resourceData.Get("branches.0.name"))
+	require.Equal(t, true, resourceData.Get("branches.0.is_default"))
+	require.Equal(t, true, resourceData.Get("branches.0.has_policies"))
+	require.Equal(t, "feature", resourceData.Get("branches.1.name"))
+	require.Equal(t, "commit-2", resourceData.Get("branches.1.commit_id"))
+	require.Equal(t, 2, resourceData.Get("branches.1.ahead_count"))
+	require.Equal(t, 1, resourceData.Get("branches.1.behind_count"))
+	require.Equal(t, false, resourceData.Get("branches.1.has_policies"))
+}
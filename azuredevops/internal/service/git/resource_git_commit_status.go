@@ -0,0 +1,298 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceGitCommitStatus schema to post a status on a git commit or a pull request
+func ResourceGitCommitStatus() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitCommitStatusCreateOrUpdate,
+		ReadContext:   resourceGitCommitStatusRead,
+		UpdateContext: resourceGitCommitStatusCreateOrUpdate,
+		DeleteContext: resourceGitCommitStatusDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"commit_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ExactlyOneOf: []string{"commit_id", "pull_request_id"},
+			},
+			"pull_request_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				ExactlyOneOf: []string{"commit_id", "pull_request_id"},
+			},
+			"iteration_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"pull_request_id"},
+			},
+			"genre": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(git.GitStatusStateValues.Pending),
+					string(git.GitStatusStateValues.Succeeded),
+					string(git.GitStatusStateValues.Failed),
+					string(git.GitStatusStateValues.Error),
+					string(git.GitStatusStateValues.NotApplicable),
+				}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"target_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"status_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceGitCommitStatusCreateOrUpdate posts a new status. Statuses are immutable, a newer status
+// with the same genre and name supersedes the previous one.
+func resourceGitCommitStatusCreateOrUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+
+	statusContext := &git.GitStatusContext{
+		Name: converter.String(d.Get("name").(string)),
+	}
+	if genre, ok := d.GetOk("genre"); ok {
+		statusContext.Genre = converter.String(genre.(string))
+	}
+	state := git.GitStatusState(d.Get("state").(string))
+
+	var statusId *int
+	if commitId, ok := d.GetOk("commit_id"); ok {
+		status, err := clients.GitReposClient.CreateCommitStatus(clients.Ctx, git.CreateCommitStatusArgs{
+			GitCommitStatusToCreate: &git.GitStatus{
+				Context:     statusContext,
+				State:       &state,
+				Description: converter.String(d.Get("description").(string)),
+				TargetUrl:   converter.String(d.Get("target_url").(string)),
+			},
+			CommitId:     converter.String(commitId.(string)),
+			RepositoryId: converter.String(repoId),
+		})
+		if err != nil {
+			return diag.Errorf("Creating status on commit %s: %+v", commitId, err)
+		}
+		statusId = status.Id
+	} else {
+		prId := d.Get("pull_request_id").(int)
+		prStatus := &git.GitPullRequestStatus{
+			Context:     statusContext,
+			State:       &state,
+			Description: converter.String(d.Get("description").(string)),
+			TargetUrl:   converter.String(d.Get("target_url").(string)),
+		}
+		if iterationId, ok := d.GetOk("iteration_id"); ok {
+			prStatus.IterationId = converter.Int(iterationId.(int))
+		}
+		status, err := clients.GitReposClient.CreatePullRequestStatus(clients.Ctx, git.CreatePullRequestStatusArgs{
+			Status:        prStatus,
+			RepositoryId:  converter.String(repoId),
+			PullRequestId: converter.Int(prId),
+		})
+		if err != nil {
+			return diag.Errorf("Creating status on pull request %d: %+v", prId, err)
+		}
+		statusId = status.Id
+	}
+
+	if statusId == nil {
+		return diag.Errorf("Creating status %s: the service returned a status without an ID", statusContextName(statusContext))
+	}
+	d.SetId(strconv.Itoa(*statusId))
+	return resourceGitCommitStatusRead(ctx, d, m)
+}
+
+func resourceGitCommitStatusRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+	genre := d.Get("genre").(string)
+	name := d.Get("name").(string)
+
+	var status *git.GitStatus
+	if commitId, ok := d.GetOk("commit_id"); ok {
+		statuses, err := clients.GitReposClient.GetStatuses(clients.Ctx, git.GetStatusesArgs{
+			CommitId:     converter.String(commitId.(string)),
+			RepositoryId: converter.String(repoId),
+			LatestOnly:   converter.Bool(true),
+		})
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				d.SetId("")
+				return nil
+			}
+			return diag.Errorf("Reading statuses of commit %s: %+v", commitId, err)
+		}
+		if statuses != nil {
+			for _, s := range *statuses {
+				if statusContextMatches(s.Context, genre, name) && (status == nil || converter.ToInt(s.Id, 0) > converter.ToInt(status.Id, 0)) {
+					status = &s
+				}
+			}
+		}
+	} else {
+		prId := d.Get("pull_request_id").(int)
+		iterationId := d.Get("iteration_id").(int)
+		statuses, err := clients.GitReposClient.GetPullRequestStatuses(clients.Ctx, git.GetPullRequestStatusesArgs{
+			RepositoryId:  converter.String(repoId),
+			PullRequestId: converter.Int(prId),
+		})
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				d.SetId("")
+				return nil
+			}
+			return diag.Errorf("Reading statuses of pull request %d: %+v", prId, err)
+		}
+		if statuses != nil {
+			for _, s := range *statuses {
+				if converter.ToInt(s.IterationId, 0) != iterationId || !statusContextMatches(s.Context, genre, name) {
+					continue
+				}
+				if status == nil || converter.ToInt(s.Id, 0) > converter.ToInt(status.Id, 0) {
+					status = &git.GitStatus{
+						Context:      s.Context,
+						CreatedBy:    s.CreatedBy,
+						CreationDate: s.CreationDate,
+						Description:  s.Description,
+						Id:           s.Id,
+						State:        s.State,
+						TargetUrl:    s.TargetUrl,
+					}
+				}
+			}
+		}
+	}
+
+	if status == nil || status.Id == nil {
+		log.Printf("[INFO] Status %s not found. Removing from state", statusContextName(&git.GitStatusContext{Genre: &genre, Name: &name}))
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(strconv.Itoa(*status.Id))
+	d.Set("status_id", *status.Id)
+	d.Set("description", converter.ToString(status.Description, ""))
+	d.Set("target_url", converter.ToString(status.TargetUrl, ""))
+	if status.State != nil {
+		d.Set("state", string(*status.State))
+	}
+	if status.CreatedBy != nil {
+		d.Set("created_by", converter.ToString(status.CreatedBy.Id, ""))
+	}
+	if status.CreationDate != nil {
+		d.Set("creation_date", status.CreationDate.Time.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func resourceGitCommitStatusDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Commit statuses cannot be deleted, they are only removed from the state.
+	if _, ok := d.GetOk("pull_request_id"); !ok {
+		return nil
+	}
+
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+	prId := d.Get("pull_request_id").(int)
+	statusId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("Parsing status ID %q: %+v", d.Id(), err)
+	}
+
+	if iterationId, ok := d.GetOk("iteration_id"); ok {
+		err = clients.GitReposClient.DeletePullRequestIterationStatus(clients.Ctx, git.DeletePullRequestIterationStatusArgs{
+			RepositoryId:  converter.String(repoId),
+			PullRequestId: converter.Int(prId),
+			IterationId:   converter.Int(iterationId.(int)),
+			StatusId:      converter.Int(statusId),
+		})
+	} else {
+		err = clients.GitReposClient.DeletePullRequestStatus(clients.Ctx, git.DeletePullRequestStatusArgs{
+			RepositoryId:  converter.String(repoId),
+			PullRequestId: converter.Int(prId),
+			StatusId:      converter.Int(statusId),
+		})
+	}
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return diag.Errorf("Deleting status %d of pull request %d: %+v", statusId, prId, err)
+	}
+	return nil
+}
+
+func statusContextMatches(statusContext *git.GitStatusContext, genre string, name string) bool {
+	if statusContext == nil {
+		return false
+	}
+	return strings.EqualFold(converter.ToString(statusContext.Genre, ""), genre) &&
+		strings.EqualFold(converter.ToString(statusContext.Name, ""), name)
+}
+
+func statusContextName(statusContext *git.GitStatusContext) string {
+	if genre := converter.ToString(statusContext.Genre, ""); genre != "" {
+		return fmt.Sprintf("%s/%s", genre, converter.ToString(statusContext.Name, ""))
+	}
+	return converter.ToString(statusContext.Name, "")
+}
@@ -0,0 +1,171 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testCommitStatusRepoId   = "a3e3a8ef-c4b5-4a35-a8e4-c30d8f4d8c88"
+	testCommitStatusCommitId = "0d5f9e2c1b7a4e3d8c6b5a49f8e7d6c5b4a39281"
+)
+
+func TestGitCommitStatus_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: gitClient,
+		Ctx:            context.Background(),
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitCommitStatus().Schema, nil)
+	d.Set("repository_id", testCommitStatusRepoId)
+	d.Set("commit_id", testCommitStatusCommitId)
+	d.Set("genre", "deployment")
+	d.Set("name", "production")
+	d.Set("state", "succeeded")
+
+	gitClient.
+		EXPECT().
+		CreateCommitStatus(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args git.CreateCommitStatusArgs) (*git.GitStatus, error) {
+			require.Equal(t, "deployment", *args.GitCommitStatusToCreate.Context.Genre)
+			require.Equal(t, "production", *args.GitCommitStatusToCreate.Context.Name)
+			require.Equal(t, git.GitStatusStateValues.Succeeded, *args.GitCommitStatusToCreate.State)
+			return nil, fmt.Errorf("CreateCommitStatus() Failed")
+		}).
+		Times(1)
+
+	diags := resourceGitCommitStatusCreateOrUpdate(context.Background(), d, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "CreateCommitStatus() Failed")
+	require.Equal(t, "", d.Id())
+}
+
+func TestGitCommitStatus_Read_UsesLatestStatusOfContext(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: gitClient,
+		Ctx:            context.Background(),
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitCommitStatus().Schema, nil)
+	d.SetId("1")
+	d.Set("repository_id", testCommitStatusRepoId)
+	d.Set("pull_request_id", 42)
+	d.Set("genre", "deployment")
+	d.Set("name", "production")
+	d.Set("state", "pending")
+
+	gitClient.
+		EXPECT().
+		GetPullRequestStatuses(clients.Ctx, git.GetPullRequestStatusesArgs{
+			RepositoryId:  converter.String(testCommitStatusRepoId),
+			PullRequestId: converter.Int(42),
+		}).
+		Return(&[]git.GitPullRequestStatus{
+			{
+				Id:      converter.Int(1),
+				Context: &git.GitStatusContext{Genre: converter.String("deployment"), Name: converter.String("production")},
+				State:   &git.GitStatusStateValues.Pending,
+			},
+			{
+				Id:      converter.Int(2),
+				Context: &git.GitStatusContext{Genre: converter.String("deployment"), Name: converter.String("production")},
+				State:   &git.GitStatusStateValues.Failed,
+			},
+			{
+				Id:          converter.Int(3),
+				IterationId: converter.Int(1),
+				Context:     &git.GitStatusContext{Genre: converter.String("deployment"), Name: converter.String("production")},
+				State:       &git.GitStatusStateValues.Succeeded,
+			},
+			{
+				Id:      converter.Int(4),
+				Context: &git.GitStatusContext{Genre: converter.String("deployment"), Name: converter.String("staging")},
+				State:   &git.GitStatusStateValues.Succeeded,
+			},
+		}, nil).
+		Times(1)
+
+	diags := resourceGitCommitStatusRead(context.Background(), d, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "2", d.Id())
+	require.Equal(t, "failed", d.Get("state"))
+}
+
+func TestGitCommitStatus_Read_RemovesMissingStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: gitClient,
+		Ctx:            context.Background(),
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitCommitStatus().Schema, nil)
+	d.SetId("1")
+	d.Set("repository_id", testCommitStatusRepoId)
+	d.Set("commit_id", testCommitStatusCommitId)
+	d.Set("name", "production")
+	d.Set("state", "pending")
+
+	gitClient.
+		EXPECT().
+		GetStatuses(clients.Ctx, gomock.Any()).
+		Return(&[]git.GitStatus{}, nil).
+		Times(1)
+
+	diags := resourceGitCommitStatusRead(context.Background(), d, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "", d.Id())
+}
+
+func TestGitCommitStatus_Delete_OnlyDeletesPullRequestStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: gitClient,
+		Ctx:            context.Background(),
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitCommitStatus().Schema, nil)
+	d.SetId("7")
+	d.Set("repository_id", testCommitStatusRepoId)
+	d.Set("commit_id", testCommitStatusCommitId)
+	require.False(t, resourceGitCommitStatusDelete(context.Background(), d, clients).HasError())
+
+	d = schema.TestResourceDataRaw(t, ResourceGitCommitStatus().Schema, nil)
+	d.SetId("7")
+	d.Set("repository_id", testCommitStatusRepoId)
+	d.Set("pull_request_id", 42)
+
+	gitClient.
+		EXPECT().
+		DeletePullRequestStatus(clients.Ctx, git.DeletePullRequestStatusArgs{
+			RepositoryId:  converter.String(testCommitStatusRepoId),
+			PullRequestId: converter.Int(42),
+			StatusId:      converter.Int(7),
+		}).
+		Return(nil).
+		Times(1)
+
+	require.False(t, resourceGitCommitStatusDelete(context.Background(), d, clients).HasError())
+}
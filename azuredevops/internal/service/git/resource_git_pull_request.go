@@ -0,0 +1,596 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// emptyIdentityID is used as AutoCompleteSetBy to cancel the auto-complete of a pull request
+const emptyIdentityID = "00000000-0000-0000-0000-000000000000"
+
+// ResourceGitPullRequest schema to manage the lifecycle of a git pull request
+func ResourceGitPullRequest() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitPullRequestCreate,
+		ReadContext:   resourceGitPullRequestRead,
+		UpdateContext: resourceGitPullRequestUpdate,
+		DeleteContext: resourceGitPullRequestDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"source_branch": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: suppressBranchPrefixDiff,
+			},
+			"target_branch": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: suppressBranchPrefixDiff,
+			},
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 400),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 4000),
+			},
+			"is_draft": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"reviewer": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"work_item_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+			"auto_complete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"completion_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"merge_strategy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(git.GitPullRequestMergeStrategyValues.NoFastForward),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(git.GitPullRequestMergeStrategyValues.NoFastForward),
+								string(git.GitPullRequestMergeStrategyValues.Squash),
+								string(git.GitPullRequestMergeStrategyValues.Rebase),
+								string(git.GitPullRequestMergeStrategyValues.RebaseMerge),
+							}, false),
+						},
+						"delete_source_branch": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"transition_work_items": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"merge_commit_message": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"bypass_policy": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"bypass_reason": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"pull_request_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"merge_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_merge_source_commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitPullRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+
+	pr := &git.GitPullRequest{
+		SourceRefName: converter.String(withPrefix(REF_BRANCH_PREFIX, d.Get("source_branch").(string))),
+		TargetRefName: converter.String(withPrefix(REF_BRANCH_PREFIX, d.Get("target_branch").(string))),
+		Title:         converter.String(d.Get("title").(string)),
+		Description:   converter.String(d.Get("description").(string)),
+		IsDraft:       converter.Bool(d.Get("is_draft").(bool)),
+	}
+
+	if reviewers := expandPullRequestReviewers(d.Get("reviewer").(*schema.Set)); len(reviewers) > 0 {
+		pr.Reviewers = &reviewers
+	}
+
+	if workItemIds := d.Get("work_item_ids").(*schema.Set).List(); len(workItemIds) > 0 {
+		refs := make([]webapi.ResourceRef, 0, len(workItemIds))
+		for _, id := range workItemIds {
+			refs = append(refs, webapi.ResourceRef{Id: converter.String(strconv.Itoa(id.(int)))})
+		}
+		pr.WorkItemRefs = &refs
+	}
+
+	createdPr, err := clients.GitReposClient.CreatePullRequest(clients.Ctx, git.CreatePullRequestArgs{
+		GitPullRequestToCreate: pr,
+		RepositoryId:           converter.String(repoId),
+	})
+	if err != nil {
+		return diag.Errorf("Creating pull request in repository %s: %+v", repoId, err)
+	}
+	if createdPr.PullRequestId == nil {
+		return diag.Errorf("Creating pull request in repository %s: the service returned a pull request without an ID", repoId)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", repoId, *createdPr.PullRequestId))
+
+	// Auto-complete can only be enabled once the pull request exists, on behalf of its creator.
+	if d.Get("auto_complete").(bool) || len(d.Get("completion_options").([]interface{})) > 0 {
+		update := &git.GitPullRequest{
+			CompletionOptions: expandPullRequestCompletionOptions(d.Get("completion_options").([]interface{})),
+		}
+		if d.Get("auto_complete").(bool) {
+			if createdPr.CreatedBy == nil || createdPr.CreatedBy.Id == nil {
+				return diag.Errorf("Enabling auto-complete on pull request %d: unable to determine the creator identity", *createdPr.PullRequestId)
+			}
+			update.AutoCompleteSetBy = &webapi.IdentityRef{Id: createdPr.CreatedBy.Id}
+		}
+		if _, err := clients.GitReposClient.UpdatePullRequest(clients.Ctx, git.UpdatePullRequestArgs{
+			GitPullRequestToUpdate: update,
+			RepositoryId:           converter.String(repoId),
+			PullRequestId:          createdPr.PullRequestId,
+		}); err != nil {
+			return diag.Errorf("Setting completion options of pull request %d: %+v", *createdPr.PullRequestId, err)
+		}
+	}
+
+	return resourceGitPullRequestRead(ctx, d, m)
+}
+
+func resourceGitPullRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId, prId, err := tfhelper.ParseGitRepoPullRequestID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pr, err := clients.GitReposClient.GetPullRequest(clients.Ctx, git.GetPullRequestArgs{
+		RepositoryId:        converter.String(repoId),
+		PullRequestId:       converter.Int(prId),
+		IncludeWorkItemRefs: converter.Bool(true),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Reading pull request %d: %+v", prId, err)
+	}
+
+	d.Set("repository_id", repoId)
+	d.Set("pull_request_id", prId)
+	d.Set("source_branch", strings.TrimPrefix(converter.ToString(pr.SourceRefName, ""), REF_BRANCH_PREFIX))
+	d.Set("target_branch", strings.TrimPrefix(converter.ToString(pr.TargetRefName, ""), REF_BRANCH_PREFIX))
+	d.Set("title", converter.ToString(pr.Title, ""))
+	d.Set("description", converter.ToString(pr.Description, ""))
+	d.Set("is_draft", converter.ToBool(pr.IsDraft, false))
+	d.Set("auto_complete", pr.AutoCompleteSetBy != nil && pr.AutoCompleteSetBy.Id != nil && *pr.AutoCompleteSetBy.Id != emptyIdentityID)
+
+	if pr.Status != nil {
+		d.Set("status", string(*pr.Status))
+	}
+	if pr.MergeStatus != nil {
+		d.Set("merge_status", string(*pr.MergeStatus))
+	}
+	if pr.CreatedBy != nil {
+		d.Set("created_by", converter.ToString(pr.CreatedBy.Id, ""))
+	}
+	if pr.CreationDate != nil {
+		d.Set("creation_date", pr.CreationDate.Time.Format(time.RFC3339))
+	}
+	if pr.LastMergeSourceCommit != nil {
+		d.Set("last_merge_source_commit_id", converter.ToString(pr.LastMergeSourceCommit.CommitId, ""))
+	}
+
+	if err := d.Set("reviewer", flattenPullRequestReviewers(d, pr.Reviewers)); err != nil {
+		return diag.Errorf("Setting reviewers of pull request %d: %+v", prId, err)
+	}
+
+	var workItemIds []int
+	if pr.WorkItemRefs != nil {
+		for _, ref := range *pr.WorkItemRefs {
+			if ref.Id == nil {
+				continue
+			}
+			if id, err := strconv.Atoi(*ref.Id); err == nil {
+				workItemIds = append(workItemIds, id)
+			}
+		}
+	}
+	d.Set("work_item_ids", workItemIds)
+
+	if _, ok := d.GetOk("completion_options"); ok {
+		d.Set("completion_options", flattenPullRequestCompletionOptions(pr.CompletionOptions))
+	}
+	return nil
+}
+
+func resourceGitPullRequestUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId, prId, err := tfhelper.ParseGitRepoPullRequestID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("title", "description", "is_draft", "target_branch", "auto_complete", "completion_options") {
+		update := &git.GitPullRequest{}
+		if d.HasChange("title") {
+			update.Title = converter.String(d.Get("title").(string))
+		}
+		if d.HasChange("description") {
+			update.Description = converter.String(d.Get("description").(string))
+		}
+		if d.HasChange("is_draft") {
+			update.IsDraft = converter.Bool(d.Get("is_draft").(bool))
+		}
+		if d.HasChange("target_branch") {
+			update.TargetRefName = converter.String(withPrefix(REF_BRANCH_PREFIX, d.Get("target_branch").(string)))
+		}
+		if d.HasChanges("auto_complete", "completion_options") {
+			update.CompletionOptions = expandPullRequestCompletionOptions(d.Get("completion_options").([]interface{}))
+			if d.Get("auto_complete").(bool) {
+				update.AutoCompleteSetBy = &webapi.IdentityRef{Id: converter.String(d.Get("created_by").(string))}
+			} else {
+				update.AutoCompleteSetBy = &webapi.IdentityRef{Id: converter.String(emptyIdentityID)}
+			}
+		}
+
+		if _, err := clients.GitReposClient.UpdatePullRequest(clients.Ctx, git.UpdatePullRequestArgs{
+			GitPullRequestToUpdate: update,
+			RepositoryId:           converter.String(repoId),
+			PullRequestId:          converter.Int(prId),
+		}); err != nil {
+			return diag.Errorf("Updating pull request %d: %+v", prId, err)
+		}
+	}
+
+	if d.HasChange("reviewer") {
+		if err := updatePullRequestReviewers(clients, d, repoId, prId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("work_item_ids") {
+		if err := updatePullRequestWorkItems(clients, d, repoId, prId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGitPullRequestRead(ctx, d, m)
+}
+
+func resourceGitPullRequestDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId, prId, err := tfhelper.ParseGitRepoPullRequestID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pr, err := clients.GitReposClient.GetPullRequest(clients.Ctx, git.GetPullRequestArgs{
+		RepositoryId:  converter.String(repoId),
+		PullRequestId: converter.Int(prId),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("Reading pull request %d: %+v", prId, err)
+	}
+
+	// Completed and abandoned pull requests cannot be deleted, they are only removed from the state.
+	if pr.Status == nil || *pr.Status != git.PullRequestStatusValues.Active {
+		return nil
+	}
+
+	if _, err := clients.GitReposClient.UpdatePullRequest(clients.Ctx, git.UpdatePullRequestArgs{
+		GitPullRequestToUpdate: &git.GitPullRequest{
+			Status: &git.PullRequestStatusValues.Abandoned,
+		},
+		RepositoryId:  converter.String(repoId),
+		PullRequestId: converter.Int(prId),
+	}); err != nil {
+		return diag.Errorf("Abandoning pull request %d: %+v", prId, err)
+	}
+	return nil
+}
+
+func updatePullRequestReviewers(clients *client.AggregatedClient, d *schema.ResourceData, repoId string, prId int) error {
+	oldRaw, newRaw := d.GetChange("reviewer")
+	oldReviewers := map[string]bool{}
+	for _, r := range expandPullRequestReviewers(oldRaw.(*schema.Set)) {
+		oldReviewers[strings.ToLower(*r.Id)] = *r.IsRequired
+	}
+	newReviewers := map[string]bool{}
+	for _, r := range expandPullRequestReviewers(newRaw.(*schema.Set)) {
+		newReviewers[strings.ToLower(*r.Id)] = *r.IsRequired
+	}
+
+	for id := range oldReviewers {
+		if _, ok := newReviewers[id]; ok {
+			continue
+		}
+		if err := clients.GitReposClient.DeletePullRequestReviewer(clients.Ctx, git.DeletePullRequestReviewerArgs{
+			RepositoryId:  converter.String(repoId),
+			PullRequestId: converter.Int(prId),
+			ReviewerId:    converter.String(id),
+		}); err != nil && !utils.ResponseWasNotFound(err) {
+			return fmt.Errorf("Removing reviewer %s from pull request %d: %+v", id, prId, err)
+		}
+	}
+
+	for id, required := range newReviewers {
+		if oldRequired, ok := oldReviewers[id]; ok && oldRequired == required {
+			continue
+		}
+		if _, err := clients.GitReposClient.CreatePullRequestReviewer(clients.Ctx, git.CreatePullRequestReviewerArgs{
+			Reviewer: &git.IdentityRefWithVote{
+				IsRequired: converter.Bool(required),
+			},
+			RepositoryId:  converter.String(repoId),
+			PullRequestId: converter.Int(prId),
+			ReviewerId:    converter.String(id),
+		}); err != nil {
+			return fmt.Errorf("Adding reviewer %s to pull request %d: %+v", id, prId, err)
+		}
+	}
+	return nil
+}
+
+// updatePullRequestWorkItems links and unlinks work items through the artifact links of the work items,
+// as the work item references of a pull request cannot be changed with the pull request API.
+func updatePullRequestWorkItems(clients *client.AggregatedClient, d *schema.ResourceData, repoId string, prId int) error {
+	pr, err := clients.GitReposClient.GetPullRequest(clients.Ctx, git.GetPullRequestArgs{
+		RepositoryId:  converter.String(repoId),
+		PullRequestId: converter.Int(prId),
+	})
+	if err != nil {
+		return fmt.Errorf("Reading pull request %d: %+v", prId, err)
+	}
+	if pr.ArtifactId == nil {
+		return fmt.Errorf("Pull request %d has no artifact ID, unable to link work items", prId)
+	}
+	artifactId := *pr.ArtifactId
+
+	oldRaw, newRaw := d.GetChange("work_item_ids")
+	oldIds := oldRaw.(*schema.Set)
+	newIds := newRaw.(*schema.Set)
+
+	for _, id := range oldIds.Difference(newIds).List() {
+		workItem, err := clients.WorkItemTrackingClient.GetWorkItem(clients.Ctx, workitemtracking.GetWorkItemArgs{
+			Id:     converter.Int(id.(int)),
+			Expand: &workitemtracking.WorkItemExpandValues.Relations,
+		})
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("Reading work item %d: %+v", id.(int), err)
+		}
+		if workItem.Relations == nil {
+			continue
+		}
+		// Relations are removed one after another, remove the last one first so that the indices stay valid
+		var operations []webapi.JsonPatchOperation
+		for idx := len(*workItem.Relations) - 1; idx >= 0; idx-- {
+			relation := (*workItem.Relations)[idx]
+			if relation.Url != nil && strings.EqualFold(*relation.Url, artifactId) {
+				operations = append(operations, webapi.JsonPatchOperation{
+					Op:   &webapi.OperationValues.Remove,
+					Path: converter.String(fmt.Sprintf("/relations/%d", idx)),
+				})
+			}
+		}
+		if len(operations) == 0 {
+			continue
+		}
+		if _, err := clients.WorkItemTrackingClient.UpdateWorkItem(clients.Ctx, workitemtracking.UpdateWorkItemArgs{
+			Id:       converter.Int(id.(int)),
+			Document: &operations,
+		}); err != nil {
+			return fmt.Errorf("Unlinking work item %d from pull request %d: %+v", id.(int), prId, err)
+		}
+	}
+
+	for _, id := range newIds.Difference(oldIds).List() {
+		if _, err := clients.WorkItemTrackingClient.UpdateWorkItem(clients.Ctx, workitemtracking.UpdateWorkItemArgs{
+			Id: converter.Int(id.(int)),
+			Document: &[]webapi.JsonPatchOperation{{
+				Op:   &webapi.OperationValues.Add,
+				Path: converter.String("/relations/-"),
+				Value: map[string]interface{}{
+					"rel": "ArtifactLink",
+					"url": artifactId,
+					"attributes": map[string]string{
+						"name": "Pull Request",
+					},
+				},
+			}},
+		}); err != nil {
+			return fmt.Errorf("Linking work item %d to pull request %d: %+v", id.(int), prId, err)
+		}
+	}
+	return nil
+}
+
+func expandPullRequestReviewers(set *schema.Set) []git.IdentityRefWithVote {
+	reviewers := make([]git.IdentityRefWithVote, 0, set.Len())
+	for _, raw := range set.List() {
+		reviewer := raw.(map[string]interface{})
+		reviewers = append(reviewers, git.IdentityRefWithVote{
+			Id:         converter.String(reviewer["id"].(string)),
+			IsRequired: converter.Bool(reviewer["required"].(bool)),
+		})
+	}
+	return reviewers
+}
+
+// flattenPullRequestReviewers only keeps the reviewers managed by Terraform, as reviewers can also be added by
+// branch policies or by users. Without configured reviewers no reviewer is managed.
+func flattenPullRequestReviewers(d *schema.ResourceData, reviewers *[]git.IdentityRefWithVote) []interface{} {
+	if reviewers == nil {
+		return nil
+	}
+
+	// keep the casing of the configured IDs to avoid spurious diffs
+	managed := map[string]string{}
+	for _, raw := range d.Get("reviewer").(*schema.Set).List() {
+		id := raw.(map[string]interface{})["id"].(string)
+		managed[strings.ToLower(id)] = id
+	}
+
+	result := make([]interface{}, 0, len(*reviewers))
+	for _, reviewer := range *reviewers {
+		if reviewer.Id == nil {
+			continue
+		}
+		id, ok := managed[strings.ToLower(*reviewer.Id)]
+		if !ok {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"id":       id,
+			"required": converter.ToBool(reviewer.IsRequired, false),
+		})
+	}
+	return result
+}
+
+func expandPullRequestCompletionOptions(input []interface{}) *git.GitPullRequestCompletionOptions {
+	if len(input) == 0 || input[0] == nil {
+		return &git.GitPullRequestCompletionOptions{
+			MergeStrategy: &git.GitPullRequestMergeStrategyValues.NoFastForward,
+		}
+	}
+	options := input[0].(map[string]interface{})
+	return &git.GitPullRequestCompletionOptions{
+		MergeStrategy:       converter.ToPtr(git.GitPullRequestMergeStrategy(options["merge_strategy"].(string))),
+		DeleteSourceBranch:  converter.Bool(options["delete_source_branch"].(bool)),
+		TransitionWorkItems: converter.Bool(options["transition_work_items"].(bool)),
+		MergeCommitMessage:  converter.String(options["merge_commit_message"].(string)),
+		BypassPolicy:        converter.Bool(options["bypass_policy"].(bool)),
+		BypassReason:        converter.String(options["bypass_reason"].(string)),
+	}
+}
+
+func flattenPullRequestCompletionOptions(options *git.GitPullRequestCompletionOptions) []interface{} {
+	if options == nil {
+		return nil
+	}
+	mergeStrategy := string(git.GitPullRequestMergeStrategyValues.NoFastForward)
+	if options.MergeStrategy != nil {
+		mergeStrategy = string(*options.MergeStrategy)
+	}
+	return []interface{}{map[string]interface{}{
+		"merge_strategy":        mergeStrategy,
+		"delete_source_branch":  converter.ToBool(options.DeleteSourceBranch, false),
+		"transition_work_items": converter.ToBool(options.TransitionWorkItems, false),
+		"merge_commit_message":  converter.ToString(options.MergeCommitMessage, ""),
+		"bypass_policy":         converter.ToBool(options.BypassPolicy, false),
+		"bypass_reason":         converter.ToString(options.BypassReason, ""),
+	}}
+}
+
+func suppressBranchPrefixDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.TrimPrefix(old, REF_BRANCH_PREFIX) == strings.TrimPrefix(new, REF_BRANCH_PREFIX)
+}
@@ -0,0 +1,200 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testPullRequestRepoId = "a3e3a8ef-c4b5-4a35-a8e4-c30d8f4d8c88"
+
+func TestGitPullRequest_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: gitClient,
+		Ctx:            context.Background(),
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, nil)
+	d.Set("repository_id", testPullRequestRepoId)
+	d.Set("source_branch", "feature")
+	d.Set("target_branch", "refs/heads/main")
+	d.Set("title", "a-title")
+
+	gitClient.
+		EXPECT().
+		CreatePullRequest(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args git.CreatePullRequestArgs) (*git.GitPullRequest, error) {
+			require.Equal(t, "refs/heads/feature", *args.GitPullRequestToCreate.SourceRefName)
+			require.Equal(t, "refs/heads/main", *args.GitPullRequestToCreate.TargetRefName)
+			return nil, fmt.Errorf("CreatePullRequest() Failed")
+		}).
+		Times(1)
+
+	diags := resourceGitPullRequestCreate(context.Background(), d, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "CreatePullRequest() Failed")
+	require.Equal(t, "", d.Id())
+}
+
+func TestGitPullRequest_Delete_AbandonsActivePullRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: gitClient,
+		Ctx:            context.Background(),
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, nil)
+	d.SetId(testPullRequestRepoId + ":42")
+
+	gitClient.
+		EXPECT().
+		GetPullRequest(clients.Ctx, git.GetPullRequestArgs{
+			RepositoryId:  converter.String(testPullRequestRepoId),
+			PullRequestId: converter.Int(42),
+		}).
+		Return(&git.GitPullRequest{Status: &git.PullRequestStatusValues.Active}, nil).
+		Times(1)
+
+	gitClient.
+		EXPECT().
+		UpdatePullRequest(clients.Ctx, git.UpdatePullRequestArgs{
+			GitPullRequestToUpdate: &git.GitPullRequest{Status: &git.PullRequestStatusValues.Abandoned},
+			RepositoryId:           converter.String(testPullRequestRepoId),
+			PullRequestId:          converter.Int(42),
+		}).
+		Return(&git.GitPullRequest{}, nil).
+		Times(1)
+
+	diags := resourceGitPullRequestDelete(context.Background(), d, clients)
+	require.False(t, diags.HasError())
+}
+
+func TestGitPullRequest_Delete_IgnoresCompletedPullRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: gitClient,
+		Ctx:            context.Background(),
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, nil)
+	d.SetId(testPullRequestRepoId + ":42")
+
+	gitClient.
+		EXPECT().
+		GetPullRequest(clients.Ctx, gomock.Any()).
+		Return(&git.GitPullRequest{Status: &git.PullRequestStatusValues.Completed}, nil).
+		Times(1)
+
+	gitClient.
+		EXPECT().
+		UpdatePullRequest(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	diags := resourceGitPullRequestDelete(context.Background(), d, clients)
+	require.False(t, diags.HasError())
+}
+
+func TestGitPullRequest_FlattenReviewers_OnlyKeepsManagedReviewers(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, nil)
+	d.Set("reviewer", []interface{}{
+		map[string]interface{}{"id": "7e1b9a8c-2f3d-4c5b-8a6e-9d0f1e2a3b4c", "required": true},
+	})
+
+	reviewers := flattenPullRequestReviewers(d, &[]git.IdentityRefWithVote{
+		{Id: converter.String("7E1B9A8C-2F3D-4C5B-8A6E-9D0F1E2A3B4C"), IsRequired: converter.Bool(true)},
+		{Id: converter.String("0c4f3a2b-1d9e-4f8a-b7c6-5e4d3c2b1a09"), IsRequired: converter.Bool(true)},
+	})
+
+	require.Len(t, reviewers, 1)
+	require.Equal(t, "7e1b9a8c-2f3d-4c5b-8a6e-9d0f1e2a3b4c", reviewers[0].(map[string]interface{})["id"])
+}
+
+func TestGitPullRequest_FlattenReviewers_IgnoresReviewersWithoutConfiguredReviewers(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, nil)
+
+	reviewers := flattenPullRequestReviewers(d, &[]git.IdentityRefWithVote{
+		{Id: converter.String("0c4f3a2b-1d9e-4f8a-b7c6-5e4d3c2b1a09"), IsRequired: converter.Bool(true)},
+	})
+
+	require.Empty(t, reviewers)
+}
+
+func TestGitPullRequest_UpdateWorkItems_RemovesRelationsInDescendingOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gitClient := azdosdkmocks.NewMockGitClient(ctrl)
+	workItemClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient:         gitClient,
+		WorkItemTrackingClient: workItemClient,
+		Ctx:                    context.Background(),
+	}
+
+	config := map[string]interface{}{
+		"repository_id": testPullRequestRepoId,
+		"source_branch": "refs/heads/feature",
+		"target_branch": "refs/heads/main",
+		"title":         "a-title",
+		"work_item_ids": []interface{}{7},
+	}
+	r := ResourceGitPullRequest()
+	state := schema.TestResourceDataRaw(t, r.Schema, config)
+	state.SetId("1")
+	delete(config, "work_item_ids")
+	diff, err := schema.InternalMap(r.Schema).Diff(context.Background(), state.State(), terraform.NewResourceConfigRaw(config), nil, nil, true)
+	require.Nil(t, err)
+	d, err := schema.InternalMap(r.Schema).Data(state.State(), diff)
+	require.Nil(t, err)
+
+	artifactId := "vstfs:///Git/PullRequestId/project%2Frepo%2F1"
+	gitClient.
+		EXPECT().
+		GetPullRequest(clients.Ctx, gomock.Any()).
+		Return(&git.GitPullRequest{ArtifactId: converter.String(artifactId)}, nil).
+		Times(1)
+	workItemClient.
+		EXPECT().
+		GetWorkItem(clients.Ctx, gomock.Any()).
+		Return(&workitemtracking.WorkItem{
+			Relations: &[]workitemtracking.WorkItemRelation{
+				{Rel: converter.String("ArtifactLink"), Url: converter.String(artifactId)},
+				{Rel: converter.String("System.LinkTypes.Related"), Url: converter.String("https://dev.azure.com/org/_apis/wit/workItems/8")},
+				{Rel: converter.String("ArtifactLink"), Url: converter.String(artifactId)},
+			},
+		}, nil).
+		Times(1)
+	workItemClient.
+		EXPECT().
+		UpdateWorkItem(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args workitemtracking.UpdateWorkItemArgs) (*workitemtracking.WorkItem, error) {
+			require.Equal(t, 7, *args.Id)
+			require.Len(t, *args.Document, 2)
+			require.Equal(t, "/relations/2", *(*args.Document)[0].Path)
+			require.Equal(t, "/relations/0", *(*args.Document)[1].Path)
+			return &workitemtracking.WorkItem{}, nil
+		}).
+		Times(1)
+
+	require.Nil(t, updatePullRequestWorkItems(clients, d, testPullRequestRepoId, 1))
+}
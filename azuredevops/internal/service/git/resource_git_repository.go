@@ -213,7 +213,9 @@ func ResourceGitRepository() *schema.Resource {
 					serviceConnectionID: initONewConfig["service_connection_id"].(string),
 				}
 
-				if !strings.EqualFold(initOld.initType, string(RepoInitTypeValues.Uninitialized)) {
+				// An empty repository, e.g. after a failed import, can be initialized in place
+				isEmpty := d.Get("default_branch").(string) == "" && d.Get("size").(int) == 0
+				if !strings.EqualFold(initOld.initType, string(RepoInitTypeValues.Uninitialized)) && !isEmpty {
 					if !strings.EqualFold(initOld.initType, initNew.initType) {
 						d.ForceNew("initialization.0.init_type")
 					}
@@ -280,7 +282,7 @@ func resourceGitRepositoryCreate(d *schema.ResourceData, m interface{}) error {
 	if repo.DefaultBranch != nil && *repo.DefaultBranch != "" {
 		createdRepo.DefaultBranch = repo.DefaultBranch
 	}
-	if err = initializeRepository(clients, initialization, createdRepo, projectID.String()); err != nil {
+	if err = initializeRepository(clients, initialization, createdRepo, projectID.String(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return err
 	}
 
@@ -383,7 +385,7 @@ func resourceGitRepositoryUpdate(d *schema.ResourceData, m interface{}) error {
 		(repoExist.Size == nil || *repoExist.Size == 0) {
 		if d.HasChange("initialization") {
 			repo.Project = repoExist.Project
-			if err = initializeRepository(clients, initialization, repo, projectID.String()); err != nil {
+			if err = initializeRepository(clients, initialization, repo, projectID.String(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return err
 			}
 		}
@@ -647,7 +649,7 @@ func updateIsDisabledGitRepository(clients *client.AggregatedClient, repoID stri
 	return repo, nil
 }
 
-func initializeRepository(clients *client.AggregatedClient, initialization *repoInitializationMeta, repository *git.GitRepository, projectId string) error {
+func initializeRepository(clients *client.AggregatedClient, initialization *repoInitializationMeta, repository *git.GitRepository, projectId string, timeout time.Duration) error {
 	if initialization != nil {
 		if strings.EqualFold(initialization.initType, string(RepoInitTypeValues.Import)) && strings.EqualFold(initialization.sourceType, "Git") {
 			importRequest := git.GitImportRequest{
@@ -697,7 +699,7 @@ func initializeRepository(clients *client.AggregatedClient, initialization *repo
 			}
 
 			// TODO validate the request before importing _apis/git/import/ImportRepositoryValidations
-			_, importErr := clients.GitReposClient.CreateImportRequest(clients.Ctx, git.CreateImportRequestArgs{
+			importRequestCreated, importErr := clients.GitReposClient.CreateImportRequest(clients.Ctx, git.CreateImportRequestArgs{
 				ImportRequest: &importRequest,
 				Project:       &projectId,
 				RepositoryId:  repository.Name,
@@ -724,6 +726,10 @@ func initializeRepository(clients *client.AggregatedClient, initialization *repo
 
 				return fmt.Errorf("Import repository in Azure DevOps: %+v ", importErr)
 			}
+
+			if err := waitForImportRequest(clients, projectId, importRequestCreated, timeout); err != nil {
+				return err
+			}
 		}
 
 		if strings.EqualFold(initialization.initType, string(RepoInitTypeValues.Clean)) {
@@ -735,3 +741,65 @@ func initializeRepository(clients *client.AggregatedClient, initialization *repo
 	}
 	return nil
 }
+
+// waitForImportRequest polls the asynchronous import request until it completes, and surfaces the
+// failure reason reported by the service when the import fails or is abandoned.
+func waitForImportRequest(clients *client.AggregatedClient, projectId string, importRequest *git.GitImportRequest, timeout time.Duration) error {
+	if importRequest == nil || importRequest.ImportRequestId == nil || importRequest.Repository == nil || importRequest.Repository.Id == nil {
+		return nil
+	}
+	repoId := importRequest.Repository.Id.String()
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			string(git.GitAsyncOperationStatusValues.Queued),
+			string(git.GitAsyncOperationStatusValues.InProgress),
+		},
+		Target: []string{
+			string(git.GitAsyncOperationStatusValues.Completed),
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := clients.GitReposClient.GetImportRequest(clients.Ctx, git.GetImportRequestArgs{
+				Project:         converter.String(projectId),
+				RepositoryId:    converter.String(repoId),
+				ImportRequestId: importRequest.ImportRequestId,
+			})
+			if err != nil {
+				return nil, "", fmt.Errorf("Retrieving import request %d: %+v", *importRequest.ImportRequestId, err)
+			}
+			if resp.Status == nil {
+				return resp, string(git.GitAsyncOperationStatusValues.Queued), nil
+			}
+
+			status := *resp.Status
+			if status == git.GitAsyncOperationStatusValues.Failed || status == git.GitAsyncOperationStatusValues.Abandoned {
+				return nil, "", fmt.Errorf("Import request %d is %s: %s", *importRequest.ImportRequestId, status, importRequestFailureReason(resp.DetailedStatus))
+			}
+			return resp, string(status), nil
+		},
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		Delay:                     2 * time.Second,
+		ContinuousTargetOccurence: 1,
+	}
+
+	if _, err := stateConf.WaitForStateContext(clients.Ctx); err != nil {
+		return fmt.Errorf("Import repository in Azure DevOps: %+v", err)
+	}
+	return nil
+}
+
+func importRequestFailureReason(detail *git.GitImportStatusDetail) string {
+	if detail == nil {
+		return "no failure detail was returned by the service"
+	}
+
+	reason := converter.ToString(detail.ErrorMessage, "no error message was returned by the service")
+	if detail.AllSteps != nil && detail.CurrentStep != nil {
+		// CurrentStep is a 1-based index into AllSteps
+		if step := *detail.CurrentStep - 1; step >= 0 && step < len(*detail.AllSteps) {
+			reason = fmt.Sprintf("%s (failed at step %q)", reason, (*detail.AllSteps)[step])
+		}
+	}
+	return reason
+}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -315,3 +316,40 @@ func TestGitRepo_Read_UsesNameIfIdNotSet(t *testing.T) {
 
 	resourceGitRepositoryRead(resourceData, clients)
 }
+
+// verifies that a failed import request surfaces the failure reason returned by the service
+func TestGitRepo_WaitForImportRequest_SurfacesFailureReason(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	importRequest := &git.GitImportRequest{
+		ImportRequestId: converter.Int(7),
+		Repository:      &testGitRepository,
+	}
+
+	reposClient.
+		EXPECT().
+		GetImportRequest(clients.Ctx, git.GetImportRequestArgs{
+			Project:         converter.String(testRepoProjectID.String()),
+			RepositoryId:    converter.String(testRepoID.String()),
+			ImportRequestId: converter.Int(7),
+		}).
+		Return(&git.GitImportRequest{
+			ImportRequestId: converter.Int(7),
+			Status:          &git.GitAsyncOperationStatusValues.Failed,
+			DetailedStatus: &git.GitImportStatusDetail{
+				AllSteps:     &[]string{"Processing request", "Analyzing repository objects"},
+				CurrentStep:  converter.Int(2),
+				ErrorMessage: converter.String("Authentication failed"),
+			},
+		}, nil).
+		Times(1)
+
+	err := waitForImportRequest(clients, testRepoProjectID.String(), importRequest, time.Minute)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Authentication failed")
+	require.Contains(t, err.Error(), `failed at step "Analyzing repository objects"`)
+}
@@ -0,0 +1,135 @@
+package graph
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataServicePrincipals schema and implementation for service principals data source
+func DataServicePrincipals() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataServicePrincipalsReadContext,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"client_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"service_principals": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"descriptor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"origin": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"origin_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"meta_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataServicePrincipalsReadContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	displayName := d.Get("display_name").(string)
+	clientID := d.Get("client_id").(string)
+
+	servicePrincipals := make([]interface{}, 0)
+	var descriptors []string
+	var continuationToken *string
+	for {
+		response, err := clients.GraphClient.ListServicePrincipals(ctx, graph.ListServicePrincipalsArgs{
+			ContinuationToken: continuationToken,
+		})
+		if err != nil {
+			return diag.Errorf("Listing service principals: %+v", err)
+		}
+		if response == nil {
+			break
+		}
+
+		if response.GraphServicePrincipals != nil {
+			for _, servicePrincipal := range *response.GraphServicePrincipals {
+				if servicePrincipal.IsDeletedInOrigin != nil && *servicePrincipal.IsDeletedInOrigin {
+					continue
+				}
+				if displayName != "" && !strings.EqualFold(converter.ToString(servicePrincipal.DisplayName, ""), displayName) {
+					continue
+				}
+				if clientID != "" && !strings.EqualFold(converter.ToString(servicePrincipal.ApplicationId, ""), clientID) {
+					continue
+				}
+				servicePrincipals = append(servicePrincipals, flattenServicePrincipal(&servicePrincipal))
+				descriptors = append(descriptors, converter.ToString(servicePrincipal.Descriptor, ""))
+			}
+		}
+
+		if response.ContinuationToken == nil || len(*response.ContinuationToken) == 0 || (*response.ContinuationToken)[0] == "" {
+			break
+		}
+		token := (*response.ContinuationToken)[0]
+		continuationToken = &token
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(displayName + "#" + clientID + "#" + strings.Join(descriptors, "-"))); err != nil {
+		return diag.FromErr(fmt.Errorf("Unable to compute hash for service principal descriptors: %v", err))
+	}
+	d.SetId("servicePrincipals#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	if err := d.Set("service_principals", servicePrincipals); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting `service_principals`: %+v", err))
+	}
+	return nil
+}
+
+func flattenServicePrincipal(servicePrincipal *graph.GraphServicePrincipal) map[string]interface{} {
+	return map[string]interface{}{
+		"descriptor":   converter.ToString(servicePrincipal.Descriptor, ""),
+		"display_name": converter.ToString(servicePrincipal.DisplayName, ""),
+		"origin":       converter.ToString(servicePrincipal.Origin, ""),
+		"origin_id":    converter.ToString(servicePrincipal.OriginId, ""),
+		"client_id":    converter.ToString(servicePrincipal.ApplicationId, ""),
+		"meta_type":    converter.ToString(servicePrincipal.MetaType, ""),
+	}
+}
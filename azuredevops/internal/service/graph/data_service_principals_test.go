@@ -0,0 +1,111 @@
+//go:build (all || core || data_sources || data_service_principals) && (!exclude_data_sources || !exclude_data_service_principals)
+// +build all core data_sources data_service_principals
+// +build !exclude_data_sources !exclude_data_service_principals
+
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDataServicePrincipals_Read_ListsAllPages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	clientID := uuid.New().String()
+	token := "next"
+	first := graphClient.
+		EXPECT().
+		ListServicePrincipals(clients.Ctx, graph.ListServicePrincipalsArgs{}).
+		Return(&graph.PagedGraphServicePrincipals{
+			ContinuationToken: &[]string{token},
+			GraphServicePrincipals: &[]graph.GraphServicePrincipal{
+				{Descriptor: converter.String("aadsp.app"), DisplayName: converter.String("app"), ApplicationId: &clientID, MetaType: converter.String("application")},
+				{Descriptor: converter.String("aadsp.deleted"), DisplayName: converter.String("deleted"), IsDeletedInOrigin: converter.Bool(true)},
+			},
+		}, nil).
+		Times(1)
+	graphClient.
+		EXPECT().
+		ListServicePrincipals(clients.Ctx, graph.ListServicePrincipalsArgs{ContinuationToken: &token}).
+		Return(&graph.PagedGraphServicePrincipals{
+			GraphServicePrincipals: &[]graph.GraphServicePrincipal{
+				{Descriptor: converter.String("aadsp.mi"), DisplayName: converter.String("identity"), MetaType: converter.String("managedIdentity")},
+			},
+		}, nil).
+		After(first).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServicePrincipals().Schema, nil)
+	diags := dataServicePrincipalsReadContext(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+
+	servicePrincipals := resourceData.Get("service_principals").([]interface{})
+	require.Len(t, servicePrincipals, 2)
+	require.Equal(t, "aadsp.app", servicePrincipals[0].(map[string]interface{})["descriptor"])
+	require.Equal(t, clientID, servicePrincipals[0].(map[string]interface{})["client_id"])
+	require.Equal(t, "managedIdentity", servicePrincipals[1].(map[string]interface{})["meta_type"])
+}
+
+func TestDataServicePrincipals_Read_FiltersByClientID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	clientID := uuid.New().String()
+	graphClient.
+		EXPECT().
+		ListServicePrincipals(clients.Ctx, gomock.Any()).
+		Return(&graph.PagedGraphServicePrincipals{
+			GraphServicePrincipals: &[]graph.GraphServicePrincipal{
+				{Descriptor: converter.String("aadsp.other"), ApplicationId: converter.String(uuid.New().String())},
+				{Descriptor: converter.String("aadsp.app"), ApplicationId: &clientID},
+			},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServicePrincipals().Schema, map[string]interface{}{
+		"client_id": clientID,
+	})
+	diags := dataServicePrincipalsReadContext(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+
+	servicePrincipals := resourceData.Get("service_principals").([]interface{})
+	require.Len(t, servicePrincipals, 1)
+	require.Equal(t, "aadsp.app", servicePrincipals[0].(map[string]interface{})["descriptor"])
+}
+
+func TestDataServicePrincipals_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		ListServicePrincipals(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("ListServicePrincipals() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServicePrincipals().Schema, nil)
+	diags := dataServicePrincipalsReadContext(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "ListServicePrincipals() Failed")
+}
@@ -1,8 +1,10 @@
 package graph
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
+	"sort"
 	"strings"
 	"time"
 
@@ -30,6 +32,7 @@ func ResourceGroupMembership() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
+		CustomizeDiff: resourceGroupMembershipCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"group": {
 				Type:         schema.TypeString,
@@ -54,10 +57,64 @@ func ResourceGroupMembership() *schema.Resource {
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
 			},
+			"expand_nested": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"transitive_members": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
 
+// resourceGroupMembershipCustomizeDiff fails the plan if a new member of the group is the group itself
+// or transitively contains the group, because the membership would create a cycle
+func resourceGroupMembershipCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// transitive members are only read while nested groups are expanded
+	if d.Get("expand_nested").(bool) && (d.HasChange("members") || d.HasChange("expand_nested")) {
+		if err := d.SetNewComputed("transitive_members"); err != nil {
+			return err
+		}
+	} else if d.HasChange("expand_nested") {
+		if err := d.SetNew("transitive_members", []string{}); err != nil {
+			return err
+		}
+	}
+
+	if !d.NewValueKnown("group") || !d.NewValueKnown("members") {
+		return nil
+	}
+
+	group := d.Get("group").(string)
+	oldMembers, newMembers := d.GetChange("members")
+	addedMembers := newMembers.(*schema.Set).Difference(oldMembers.(*schema.Set))
+	if addedMembers.Len() == 0 {
+		return nil
+	}
+
+	clients, ok := m.(*client.AggregatedClient)
+	if !ok || clients == nil || clients.GraphClient == nil {
+		return nil
+	}
+
+	for _, member := range addedMembers.List() {
+		cycle, err := createsMembershipCycle(clients, group, member.(string))
+		if err != nil {
+			return fmt.Errorf("Checking member %s of group %s for membership cycles: %+v", member, group, err)
+		}
+		if cycle {
+			return fmt.Errorf("Adding member %s to group %s would create a membership cycle, because the group is already a (nested) member of %s", member, group, member)
+		}
+	}
+	return nil
+}
+
 func resourceGroupMembershipCreate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	group := d.Get("group").(string)
@@ -139,6 +196,15 @@ func resourceGroupMembershipRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.Set("members", members)
+
+	transitiveMembers := []string{}
+	if d.Get("expand_nested").(bool) {
+		transitiveMembers, err = getTransitiveGroupMembers(clients, group)
+		if err != nil {
+			return fmt.Errorf("Reading transitive group memberships: %+v", err)
+		}
+	}
+	d.Set("transitive_members", transitiveMembers)
 	return nil
 }
 
@@ -146,7 +212,7 @@ func resourceGroupMembershipUpdate(d *schema.ResourceData, m interface{}) error
 	clients := m.(*client.AggregatedClient)
 
 	if !d.HasChange("members") {
-		return nil
+		return resourceGroupMembershipRead(d, m)
 	}
 
 	group := d.Get("group").(string)
@@ -338,3 +404,78 @@ func getGroupMembershipSet(members *[]graph.GraphMembership) *set.Set[string] {
 func schemaSetToGoSet(s *schema.Set) *set.Set[string] {
 	return set.FromFunc(s.List(), func(v interface{}) string { return v.(string) })
 }
+
+// getTransitiveGroupMembers returns the direct and nested members of a group. Nested Azure DevOps and Entra groups
+// are expanded, every member is only reported once, even if it is a member of several nested groups.
+func getTransitiveGroupMembers(clients *client.AggregatedClient, groupDescriptor string) ([]string, error) {
+	members := []string{}
+	err := walkNestedGroupMembers(clients, groupDescriptor, func(member string) bool {
+		members = append(members, member)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(members)
+	return members, nil
+}
+
+// createsMembershipCycle returns true, if the group is the member itself or a (nested) member of the member
+func createsMembershipCycle(clients *client.AggregatedClient, groupDescriptor string, memberDescriptor string) (bool, error) {
+	if strings.EqualFold(groupDescriptor, memberDescriptor) {
+		return true, nil
+	}
+	if !isGroupDescriptor(memberDescriptor) {
+		return false, nil
+	}
+
+	cycle := false
+	err := walkNestedGroupMembers(clients, memberDescriptor, func(member string) bool {
+		cycle = strings.EqualFold(member, groupDescriptor)
+		return !cycle
+	})
+	return cycle, err
+}
+
+// walkNestedGroupMembers traverses the membership tree of a group breadth first and calls visit once for every
+// direct or nested member. The traversal stops, if visit returns false.
+func walkNestedGroupMembers(clients *client.AggregatedClient, groupDescriptor string, visit func(member string) bool) error {
+	visited := set.From([]string{strings.ToLower(groupDescriptor)})
+	queue := []string{groupDescriptor}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		memberships, err := getGroupMemberships(clients, current)
+		if err != nil {
+			return err
+		}
+		if memberships == nil {
+			continue
+		}
+		for _, membership := range *memberships {
+			if membership.MemberDescriptor == nil {
+				continue
+			}
+			member := *membership.MemberDescriptor
+			if !visited.Insert(strings.ToLower(member)) {
+				continue
+			}
+			if !visit(member) {
+				return nil
+			}
+			if isGroupDescriptor(member) {
+				queue = append(queue, member)
+			}
+		}
+	}
+	return nil
+}
+
+// isGroupDescriptor returns true for descriptors of Azure DevOps groups (vssgp) and Entra groups (aadgp)
+func isGroupDescriptor(descriptor string) bool {
+	descriptor = strings.ToLower(descriptor)
+	return strings.HasPrefix(descriptor, "vssgp.") || strings.HasPrefix(descriptor, "aadgp.")
+}
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
@@ -92,9 +93,111 @@ func TestGroupMembership_Read_DoesNotSwallowErrors(t *testing.T) {
 	require.Contains(t, err.Error(), "ListMemberships() Failed")
 }
 
+func TestGroupMembership_GetTransitiveGroupMembers_ExpandsNestedGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	expectGroupMembers(clients, graphClient, "vssgp.root", "aad.user1", "vssgp.nested", "aadgp.entra")
+	expectGroupMembers(clients, graphClient, "vssgp.nested", "aad.user2", "aad.user1")
+	expectGroupMembers(clients, graphClient, "aadgp.entra", "aad.user3", "vssgp.root")
+
+	members, err := getTransitiveGroupMembers(clients, "vssgp.root")
+	require.Nil(t, err)
+	require.Equal(t, []string{"aad.user1", "aad.user2", "aad.user3", "aadgp.entra", "vssgp.nested"}, members)
+}
+
+func TestGroupMembership_CreatesMembershipCycle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	expectGroupMembers(clients, graphClient, "vssgp.parent", "vssgp.child")
+	expectGroupMembers(clients, graphClient, "vssgp.child", "aad.user", "vssgp.group")
+
+	cycle, err := createsMembershipCycle(clients, "vssgp.group", "vssgp.parent")
+	require.Nil(t, err)
+	require.True(t, cycle)
+
+	cycle, err = createsMembershipCycle(clients, "vssgp.group", "VSSGP.group")
+	require.Nil(t, err)
+	require.True(t, cycle)
+
+	cycle, err = createsMembershipCycle(clients, "vssgp.group", "aad.user")
+	require.Nil(t, err)
+	require.False(t, cycle)
+}
+
+func TestGroupMembership_CreatesMembershipCycle_NoCycle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	expectGroupMembers(clients, graphClient, "vssgp.other", "aad.user")
+
+	cycle, err := createsMembershipCycle(clients, "vssgp.group", "vssgp.other")
+	require.Nil(t, err)
+	require.False(t, cycle)
+}
+
+func expectGroupMembers(clients *client.AggregatedClient, graphClient *azdosdkmocks.MockGraphClient, group string, members ...string) {
+	memberships := make([]graph.GraphMembership, 0, len(members))
+	for _, member := range members {
+		memberships = append(memberships, graph.GraphMembership{
+			ContainerDescriptor: converter.String(group),
+			MemberDescriptor:    converter.String(member),
+		})
+	}
+	graphClient.
+		EXPECT().
+		ListMemberships(clients.Ctx, graph.ListMembershipsArgs{
+			SubjectDescriptor: converter.String(group),
+			Direction:         &graph.GraphTraversalDirectionValues.Down,
+			Depth:             converter.Int(1),
+		}).
+		Return(&memberships, nil).
+		Times(1)
+}
+
 func getGroupMembershipResourceData(t *testing.T, group string, members ...string) *schema.ResourceData {
 	d := schema.TestResourceDataRaw(t, ResourceGroupMembership().Schema, nil)
 	d.Set("group", group)
 	d.Set("members", members)
 	return d
 }
+
+func TestGroupMembership_CustomizeDiff_RecomputesTransitiveMembersWhenExpansionChanges(t *testing.T) {
+	r := ResourceGroupMembership()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"group":         "vssgp.root",
+		"members":       []interface{}{"vssgp.nested"},
+		"expand_nested": true,
+	})
+	resourceData.SetId("1")
+	resourceData.Set("transitive_members", []interface{}{"aad.user1", "vssgp.nested"})
+	state := resourceData.State()
+
+	config := map[string]interface{}{
+		"group":         "vssgp.root",
+		"members":       []interface{}{"vssgp.nested"},
+		"expand_nested": false,
+	}
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	require.Nil(t, err)
+	require.NotNil(t, diff.Attributes["transitive_members.#"])
+	require.Equal(t, "0", diff.Attributes["transitive_members.#"].New)
+
+	resourceData.Set("expand_nested", false)
+	resourceData.Set("transitive_members", []interface{}{})
+	config["expand_nested"] = true
+	diff, err = r.Diff(context.Background(), resourceData.State(), terraform.NewResourceConfigRaw(config), nil)
+	require.Nil(t, err)
+	require.NotNil(t, diff.Attributes["transitive_members.#"])
+	require.True(t, diff.Attributes["transitive_members.#"].NewComputed)
+}
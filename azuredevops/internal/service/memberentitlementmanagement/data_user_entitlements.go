@@ -0,0 +1,299 @@
+package memberentitlementmanagement
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/licensing"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// timeNow returns the current time, it is replaced in tests
+var timeNow = time.Now
+
+// licenseIDs maps the account license types to the license IDs used by the search filter
+var licenseIDs = map[string]string{
+	strings.ToLower(string(licensing.AccountLicenseTypeValues.Advanced)):     "Account-Advanced",
+	strings.ToLower(string(licensing.AccountLicenseTypeValues.EarlyAdopter)): "Account-EarlyAdopter",
+	strings.ToLower(string(licensing.AccountLicenseTypeValues.Express)):      "Account-Express",
+	"basic": "Account-Express",
+	strings.ToLower(string(licensing.AccountLicenseTypeValues.Professional)): "Account-Professional",
+	strings.ToLower(string(licensing.AccountLicenseTypeValues.Stakeholder)):  "Account-Stakeholder",
+}
+
+// DataUserEntitlements schema and implementation for user entitlements data source
+func DataUserEntitlements() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataUserEntitlementsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"license_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(licensing.AccountLicenseTypeValues.Advanced),
+					string(licensing.AccountLicenseTypeValues.EarlyAdopter),
+					string(licensing.AccountLicenseTypeValues.Express),
+					"basic",
+					string(licensing.AccountLicenseTypeValues.Professional),
+					string(licensing.AccountLicenseTypeValues.Stakeholder),
+				}, true),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"inactive_since_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"descriptor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"origin": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"origin_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_license_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"licensing_source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"license_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date_created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_accessed_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group_assignments": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"display_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataUserEntitlementsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	filter := userEntitlementsSearchFilter(d.Get("license_type").(string), d.Get("name").(string))
+	userEntitlements, err := searchUserEntitlements(clients, filter,
+		memberentitlementmanagement.UserEntitlementPropertyValues.License,
+		memberentitlementmanagement.UserEntitlementPropertyValues.GroupRules)
+	if err != nil {
+		return diag.Errorf("Searching user entitlements: %+v", err)
+	}
+
+	var inactiveSince *time.Time
+	if days, ok := d.GetOk("inactive_since_days"); ok {
+		cutoff := timeNow().UTC().AddDate(0, 0, -days.(int))
+		inactiveSince = &cutoff
+	}
+
+	users := make([]interface{}, 0, len(userEntitlements))
+	ids := make([]string, 0, len(userEntitlements))
+	for _, userEntitlement := range userEntitlements {
+		if inactiveSince != nil && !userEntitlementInactiveSince(userEntitlement, *inactiveSince) {
+			continue
+		}
+		users = append(users, flattenDataUserEntitlement(userEntitlement))
+		if userEntitlement.Id != nil {
+			ids = append(ids, userEntitlement.Id.String())
+		}
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(filter + "#" + strings.Join(ids, "-"))); err != nil {
+		return diag.Errorf("Unable to compute hash for user entitlements: %v", err)
+	}
+	d.SetId("userEntitlements#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	if err := d.Set("users", users); err != nil {
+		return diag.Errorf("Error setting `users`: %+v", err)
+	}
+	return nil
+}
+
+// searchUserEntitlements returns all pages of user entitlements matching the filter, including the selected properties
+func searchUserEntitlements(clients *client.AggregatedClient, filter string, properties ...memberentitlementmanagement.UserEntitlementProperty) ([]memberentitlementmanagement.UserEntitlement, error) {
+	names := make([]string, 0, len(properties))
+	for _, property := range properties {
+		names = append(names, string(property))
+	}
+	selectProperties := memberentitlementmanagement.UserEntitlementProperty(strings.Join(names, ","))
+
+	var result []memberentitlementmanagement.UserEntitlement
+	var continuationToken *string
+	for {
+		args := memberentitlementmanagement.SearchUserEntitlementsArgs{
+			ContinuationToken: continuationToken,
+			Select:            &selectProperties,
+			OrderBy:           converter.String("name"),
+		}
+		if filter != "" {
+			args.Filter = converter.String(filter)
+		}
+
+		page, err := clients.MemberEntitleManagementExtras.SearchUserEntitlements(clients.Ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		if page == nil {
+			break
+		}
+		if page.Members != nil {
+			result = append(result, *page.Members...)
+		}
+		if page.ContinuationToken == nil || *page.ContinuationToken == "" {
+			break
+		}
+		continuationToken = page.ContinuationToken
+	}
+	return result, nil
+}
+
+func userEntitlementsSearchFilter(licenseType string, name string) string {
+	var clauses []string
+	if licenseID, ok := licenseIDs[strings.ToLower(licenseType)]; ok {
+		clauses = append(clauses, fmt.Sprintf("licenseId eq '%s'", licenseID))
+	}
+	if name != "" {
+		clauses = append(clauses, fmt.Sprintf("name eq '%s'", strings.ReplaceAll(name, "'", "''")))
+	}
+	return strings.Join(clauses, " and ")
+}
+
+// userEntitlementInactiveSince reports whether the user has not accessed the organization since the cutoff.
+// Users who never accessed the organization are inactive, if they have been added before the cutoff.
+func userEntitlementInactiveSince(userEntitlement memberentitlementmanagement.UserEntitlement, cutoff time.Time) bool {
+	if lastAccessed := userEntitlementTime(userEntitlement.LastAccessedDate); lastAccessed != nil {
+		return lastAccessed.Before(cutoff)
+	}
+	if created := userEntitlementTime(userEntitlement.DateCreated); created != nil {
+		return created.Before(cutoff)
+	}
+	return true
+}
+
+func flattenDataUserEntitlement(userEntitlement memberentitlementmanagement.UserEntitlement) map[string]interface{} {
+	user := map[string]interface{}{
+		"id":                 "",
+		"date_created":       "",
+		"last_accessed_date": "",
+		"group_assignments":  []interface{}{},
+	}
+	if userEntitlement.Id != nil {
+		user["id"] = userEntitlement.Id.String()
+	}
+	if created := userEntitlementTime(userEntitlement.DateCreated); created != nil {
+		user["date_created"] = created.Format(time.RFC3339)
+	}
+	if lastAccessed := userEntitlementTime(userEntitlement.LastAccessedDate); lastAccessed != nil {
+		user["last_accessed_date"] = lastAccessed.Format(time.RFC3339)
+	}
+
+	if userEntitlement.User != nil {
+		user["descriptor"] = converter.ToString(userEntitlement.User.Descriptor, "")
+		user["principal_name"] = converter.ToString(userEntitlement.User.PrincipalName, "")
+		user["display_name"] = converter.ToString(userEntitlement.User.DisplayName, "")
+		user["origin"] = converter.ToString(userEntitlement.User.Origin, "")
+		user["origin_id"] = converter.ToString(userEntitlement.User.OriginId, "")
+	}
+
+	if accessLevel := userEntitlement.AccessLevel; accessLevel != nil {
+		if accessLevel.AccountLicenseType != nil {
+			user["account_license_type"] = string(*accessLevel.AccountLicenseType)
+		}
+		if accessLevel.LicensingSource != nil {
+			user["licensing_source"] = string(*accessLevel.LicensingSource)
+		}
+		if accessLevel.Status != nil {
+			user["license_status"] = string(*accessLevel.Status)
+		}
+	}
+
+	if userEntitlement.GroupAssignments != nil {
+		groups := make([]interface{}, 0, len(*userEntitlement.GroupAssignments))
+		for _, groupAssignment := range *userEntitlement.GroupAssignments {
+			group := map[string]interface{}{"id": "", "display_name": ""}
+			if groupAssignment.Id != nil {
+				group["id"] = groupAssignment.Id.String()
+			}
+			if groupAssignment.Group != nil {
+				group["display_name"] = converter.ToString(groupAssignment.Group.DisplayName, "")
+			}
+			groups = append(groups, group)
+		}
+		user["group_assignments"] = groups
+	}
+	return user
+}
+
+// userEntitlementTime returns the time of a date attribute, the service reports dates which are not set as
+// the zero time or as 9999-12-31
+func userEntitlementTime(value *azuredevops.Time) *time.Time {
+	if value == nil || value.Time.IsZero() || value.Time.Year() <= 1 || value.Time.Year() >= 9999 {
+		return nil
+	}
+	t := value.Time.UTC()
+	return &t
+}
@@ -0,0 +1,110 @@
+//go:build (all || data_user_entitlements) && !exclude_data_user_entitlements
+// +build all data_user_entitlements
+// +build !exclude_data_user_entitlements
+
+package memberentitlementmanagement
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/licensing"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/memberentitlementmanagementextras"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDataUserEntitlements_Read_PagesAndFiltersInactiveUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	currentTimeNow := timeNow
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = currentTimeNow }()
+
+	extrasClient := azdosdkmocks.NewMockMemberentitlementmanagementextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementExtras: extrasClient,
+		Ctx:                           context.Background(),
+	}
+
+	stale := getMockSearchUserEntitlement("stale@example.com", now.AddDate(0, 0, -120), now.AddDate(-1, 0, 0))
+	active := getMockSearchUserEntitlement("active@example.com", now.AddDate(0, 0, -5), now.AddDate(-1, 0, 0))
+	neverAccessed := getMockSearchUserEntitlement("never@example.com", time.Time{}, now.AddDate(0, -6, 0))
+
+	firstPage := extrasClient.
+		EXPECT().
+		SearchUserEntitlements(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args memberentitlementmanagement.SearchUserEntitlementsArgs) (*memberentitlementmanagementextras.PagedUserEntitlements, error) {
+			require.Nil(t, args.ContinuationToken)
+			require.Equal(t, "licenseId eq 'Account-Express'", *args.Filter)
+			return &memberentitlementmanagementextras.PagedUserEntitlements{
+				ContinuationToken: converter.String("next"),
+				Members:           &[]memberentitlementmanagement.UserEntitlement{stale, active},
+			}, nil
+		}).
+		Times(1)
+	extrasClient.
+		EXPECT().
+		SearchUserEntitlements(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args memberentitlementmanagement.SearchUserEntitlementsArgs) (*memberentitlementmanagementextras.PagedUserEntitlements, error) {
+			require.Equal(t, "next", *args.ContinuationToken)
+			return &memberentitlementmanagementextras.PagedUserEntitlements{
+				Members: &[]memberentitlementmanagement.UserEntitlement{neverAccessed},
+			}, nil
+		}).
+		After(firstPage).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataUserEntitlements().Schema, map[string]interface{}{
+		"license_type":        "basic",
+		"inactive_since_days": 90,
+	})
+
+	diags := dataUserEntitlementsRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+
+	users := resourceData.Get("users").([]interface{})
+	require.Len(t, users, 2)
+	require.Equal(t, "stale@example.com", users[0].(map[string]interface{})["principal_name"])
+	require.Equal(t, now.AddDate(0, 0, -120).Format(time.RFC3339), users[0].(map[string]interface{})["last_accessed_date"])
+	require.Equal(t, "Contributors", users[0].(map[string]interface{})["group_assignments"].([]interface{})[0].(map[string]interface{})["display_name"])
+	require.Equal(t, "never@example.com", users[1].(map[string]interface{})["principal_name"])
+	require.Equal(t, "", users[1].(map[string]interface{})["last_accessed_date"])
+}
+
+func TestDataUserEntitlements_SearchFilter(t *testing.T) {
+	require.Equal(t, "", userEntitlementsSearchFilter("", ""))
+	require.Equal(t, "licenseId eq 'Account-Stakeholder'", userEntitlementsSearchFilter("Stakeholder", ""))
+	require.Equal(t, "licenseId eq 'Account-Advanced' and name eq 'o''brien'", userEntitlementsSearchFilter("advanced", "o'brien"))
+}
+
+func getMockSearchUserEntitlement(principalName string, lastAccessed time.Time, created time.Time) memberentitlementmanagement.UserEntitlement {
+	id := uuid.New()
+	groupID := uuid.New()
+	accountLicenseType := licensing.AccountLicenseTypeValues.Express
+	return memberentitlementmanagement.UserEntitlement{
+		Id:               &id,
+		DateCreated:      &azuredevops.Time{Time: created},
+		LastAccessedDate: &azuredevops.Time{Time: lastAccessed},
+		AccessLevel: &licensing.AccessLevel{
+			AccountLicenseType: &accountLicenseType,
+		},
+		User: &graph.GraphUser{
+			PrincipalName: converter.String(principalName),
+		},
+		GroupAssignments: &[]memberentitlementmanagement.GroupEntitlement{
+			{Id: &groupID, Group: &graph.GraphGroup{DisplayName: converter.String("Contributors")}},
+		},
+	}
+}
@@ -0,0 +1,219 @@
+package memberentitlementmanagement
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// projectEntitlementSchema returns the schema of the project_entitlement blocks, which assign a project
+// membership with a project group type
+func projectEntitlementSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"project_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsUUID,
+				},
+				"group_type": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  string(memberentitlementmanagement.GroupTypeValues.ProjectContributor),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(memberentitlementmanagement.GroupTypeValues.ProjectStakeholder),
+						string(memberentitlementmanagement.GroupTypeValues.ProjectReader),
+						string(memberentitlementmanagement.GroupTypeValues.ProjectContributor),
+						string(memberentitlementmanagement.GroupTypeValues.ProjectAdministrator),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+// extensionSchema returns the schema of the extension blocks, which assign an extension license
+func extensionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+	}
+}
+
+func expandProjectEntitlements(set *schema.Set) (*[]memberentitlementmanagement.ProjectEntitlement, error) {
+	if set == nil || set.Len() == 0 {
+		return nil, nil
+	}
+
+	projectEntitlements := make([]memberentitlementmanagement.ProjectEntitlement, 0, set.Len())
+	for _, raw := range set.List() {
+		item := raw.(map[string]interface{})
+		projectID, err := uuid.Parse(item["project_id"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("Parsing project ID %s: %v", item["project_id"].(string), err)
+		}
+		groupType := memberentitlementmanagement.GroupType(item["group_type"].(string))
+		projectEntitlements = append(projectEntitlements, memberentitlementmanagement.ProjectEntitlement{
+			Group:      &memberentitlementmanagement.Group{GroupType: &groupType},
+			ProjectRef: &memberentitlementmanagement.ProjectRef{Id: &projectID},
+		})
+	}
+	return &projectEntitlements, nil
+}
+
+func expandExtensions(set *schema.Set) *[]memberentitlementmanagement.Extension {
+	if set == nil || set.Len() == 0 {
+		return nil
+	}
+
+	extensions := make([]memberentitlementmanagement.Extension, 0, set.Len())
+	for _, raw := range set.List() {
+		item := raw.(map[string]interface{})
+		extensions = append(extensions, memberentitlementmanagement.Extension{
+			Id: converter.String(item["id"].(string)),
+		})
+	}
+	return &extensions
+}
+
+// flattenProjectEntitlements reports the current project entitlements of the configured projects. Project
+// entitlements of other projects, e.g. assigned by group rules or in the web interface, are ignored.
+func flattenProjectEntitlements(configured *schema.Set, current *[]memberentitlementmanagement.ProjectEntitlement) []interface{} {
+	result := []interface{}{}
+	if configured == nil {
+		return result
+	}
+	for _, raw := range configured.List() {
+		item := raw.(map[string]interface{})
+		projectEntitlement := findProjectEntitlement(current, item["project_id"].(string))
+		if projectEntitlement == nil {
+			continue
+		}
+		groupType := item["group_type"].(string)
+		if projectEntitlement.Group != nil && projectEntitlement.Group.GroupType != nil &&
+			!strings.EqualFold(string(*projectEntitlement.Group.GroupType), groupType) {
+			groupType = string(*projectEntitlement.Group.GroupType)
+		}
+		result = append(result, map[string]interface{}{
+			"project_id": item["project_id"].(string),
+			"group_type": groupType,
+		})
+	}
+	return result
+}
+
+// flattenExtensions reports the configured extensions, which are currently assigned
+func flattenExtensions(configured *schema.Set, current *[]memberentitlementmanagement.Extension) []interface{} {
+	result := []interface{}{}
+	if configured == nil || current == nil {
+		return result
+	}
+	for _, raw := range configured.List() {
+		id := raw.(map[string]interface{})["id"].(string)
+		for _, extension := range *current {
+			if strings.EqualFold(converter.ToString(extension.Id, ""), id) {
+				result = append(result, map[string]interface{}{"id": id})
+				break
+			}
+		}
+	}
+	return result
+}
+
+func findProjectEntitlement(projectEntitlements *[]memberentitlementmanagement.ProjectEntitlement, projectID string) *memberentitlementmanagement.ProjectEntitlement {
+	if projectEntitlements == nil {
+		return nil
+	}
+	for i, projectEntitlement := range *projectEntitlements {
+		if projectEntitlement.ProjectRef != nil && projectEntitlement.ProjectRef.Id != nil &&
+			strings.EqualFold(projectEntitlement.ProjectRef.Id.String(), projectID) {
+			return &(*projectEntitlements)[i]
+		}
+	}
+	return nil
+}
+
+// projectEntitlementOperations returns the patch operations, which add or change the project entitlements
+// of the new set and remove the project entitlements of projects which are not part of the new set anymore
+func projectEntitlementOperations(oldSet *schema.Set, newSet *schema.Set) ([]webapi.JsonPatchOperation, error) {
+	var operations []webapi.JsonPatchOperation
+
+	added, err := expandProjectEntitlements(newSet.Difference(oldSet))
+	if err != nil {
+		return nil, err
+	}
+	if added != nil {
+		for _, projectEntitlement := range *added {
+			operations = append(operations, webapi.JsonPatchOperation{
+				Op:    &webapi.OperationValues.Add,
+				Path:  converter.String(fmt.Sprintf("/projectEntitlements/%s", projectEntitlement.ProjectRef.Id.String())),
+				Value: projectEntitlement,
+			})
+		}
+	}
+
+	for _, raw := range oldSet.Difference(newSet).List() {
+		projectID := raw.(map[string]interface{})["project_id"].(string)
+		if findSetItem(newSet, "project_id", projectID) {
+			continue
+		}
+		operations = append(operations, webapi.JsonPatchOperation{
+			Op:   &webapi.OperationValues.Remove,
+			Path: converter.String(fmt.Sprintf("/projectEntitlements/%s", projectID)),
+		})
+	}
+	return operations, nil
+}
+
+// extensionOperations returns the patch operations, which assign the added extensions and unassign the
+// removed extensions below the given path
+func extensionOperations(path string, oldSet *schema.Set, newSet *schema.Set) []webapi.JsonPatchOperation {
+	var operations []webapi.JsonPatchOperation
+
+	added := expandExtensions(newSet.Difference(oldSet))
+	if added != nil {
+		for _, extension := range *added {
+			operations = append(operations, webapi.JsonPatchOperation{
+				Op:    &webapi.OperationValues.Add,
+				Path:  converter.String(fmt.Sprintf("%s/%s", path, *extension.Id)),
+				Value: extension,
+			})
+		}
+	}
+
+	for _, raw := range oldSet.Difference(newSet).List() {
+		id := raw.(map[string]interface{})["id"].(string)
+		operations = append(operations, webapi.JsonPatchOperation{
+			Op:   &webapi.OperationValues.Remove,
+			Path: converter.String(fmt.Sprintf("%s/%s", path, id)),
+		})
+	}
+	return operations
+}
+
+func findSetItem(set *schema.Set, key string, value string) bool {
+	for _, raw := range set.List() {
+		if strings.EqualFold(raw.(map[string]interface{})[key].(string), value) {
+			return true
+		}
+	}
+	return false
+}
@@ -109,6 +109,8 @@ func ResourceGroupEntitlement() *schema.Resource {
 				}, true),
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
+			"project_entitlement": projectEntitlementSchema(),
+			"extension":           extensionSchema(),
 			"principal_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -182,25 +184,38 @@ func resourceGroupEntitlementUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Reading account licensing source for GroupEntitlementID: %s", groupEntitlementID)
 	}
 
+	document := []webapi.JsonPatchOperation{
+		{
+			Op:   &webapi.OperationValues.Replace,
+			From: nil,
+			Path: converter.String("/accessLevel"),
+			Value: struct {
+				AccountLicenseType string `json:"accountLicenseType"`
+				LicensingSource    string `json:"licensingSource"`
+			}{
+				string(*accountLicenseType),
+				licensingSource.(string),
+			},
+		},
+	}
+
+	oldProjects, newProjects := d.GetChange("project_entitlement")
+	projectOperations, err := projectEntitlementOperations(oldProjects.(*schema.Set), newProjects.(*schema.Set))
+	if err != nil {
+		return fmt.Errorf("Updating group entitlement: %v", err)
+	}
+	document = append(document, projectOperations...)
+
+	// extension licenses of a group are assigned by extension rules, which apply to all members of the group
+	oldExtensions, newExtensions := d.GetChange("extension")
+	document = append(document, extensionOperations("/extensionRules", oldExtensions.(*schema.Set), newExtensions.(*schema.Set))...)
+
 	clients := m.(*client.AggregatedClient)
 
 	patchResponse, err := clients.MemberEntitleManagementClient.UpdateGroupEntitlement(clients.Ctx,
 		memberentitlementmanagement.UpdateGroupEntitlementArgs{
-			GroupId: &id,
-			Document: &[]webapi.JsonPatchOperation{
-				{
-					Op:   &webapi.OperationValues.Replace,
-					From: nil,
-					Path: converter.String("/accessLevel"),
-					Value: struct {
-						AccountLicenseType string `json:"accountLicenseType"`
-						LicensingSource    string `json:"licensingSource"`
-					}{
-						string(*accountLicenseType),
-						licensingSource.(string),
-					},
-				},
-			},
+			GroupId:  &id,
+			Document: &document,
 		})
 	if err != nil {
 		return fmt.Errorf("Updating group entitlement: %v", err)
@@ -283,6 +298,8 @@ func flattenGroupEntitlement(d *schema.ResourceData, groupEntitlement *memberent
 	d.Set("display_name", *groupEntitlement.Group.DisplayName)
 	d.Set("account_license_type", string(*groupEntitlement.LicenseRule.AccountLicenseType))
 	d.Set("licensing_source", *groupEntitlement.LicenseRule.LicensingSource)
+	d.Set("project_entitlement", flattenProjectEntitlements(d.Get("project_entitlement").(*schema.Set), groupEntitlement.ProjectEntitlements))
+	d.Set("extension", flattenExtensions(d.Get("extension").(*schema.Set), groupEntitlement.ExtensionRules))
 }
 
 func expandGroupEntitlement(d *schema.ResourceData) (*memberentitlementmanagement.GroupEntitlement, error) {
@@ -299,11 +316,18 @@ func expandGroupEntitlement(d *schema.ResourceData) (*memberentitlementmanagemen
 		return nil, err
 	}
 
+	projectEntitlements, err := expandProjectEntitlements(d.Get("project_entitlement").(*schema.Set))
+	if err != nil {
+		return nil, err
+	}
+
 	return &memberentitlementmanagement.GroupEntitlement{
 		LicenseRule: &licensing.AccessLevel{
 			AccountLicenseType: accountLicenseType,
 			LicensingSource:    licensingSource,
 		},
+		ProjectEntitlements: projectEntitlements,
+		ExtensionRules:      expandExtensions(d.Get("extension").(*schema.Set)),
 
 		Group: &graph.GraphGroup{
 			Origin:      &origin,
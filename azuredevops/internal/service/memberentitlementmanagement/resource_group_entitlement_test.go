@@ -510,6 +510,76 @@ func TestGroupEntitlement_Update_TestEmptyErrors(t *testing.T) {
 	assert.Contains(t, err.Error(), "Unknown API error")
 }
 
+// TestGroupEntitlement_Create_WithProjectAndExtensionEntitlements verifies that the project entitlements and
+// extension rules are sent with the group rule and read back
+func TestGroupEntitlement_Create_WithProjectAndExtensionEntitlements(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClient: memberEntitlementClient,
+		Ctx:                           context.Background(),
+	}
+
+	id := uuid.New()
+	projectID := uuid.New()
+	groupType := memberentitlementmanagement.GroupTypeValues.ProjectReader
+	mockGroupEntitlement := getMockGroupEntitlement(&id, licensing.AccountLicenseTypeValues.Express, "", "", "[contoso]\\displayName", "displayName", "baz")
+	mockGroupEntitlement.ProjectEntitlements = &[]memberentitlementmanagement.ProjectEntitlement{
+		{
+			Group:      &memberentitlementmanagement.Group{GroupType: &groupType},
+			ProjectRef: &memberentitlementmanagement.ProjectRef{Id: &projectID},
+		},
+	}
+	mockGroupEntitlement.ExtensionRules = &[]memberentitlementmanagement.Extension{
+		{Id: converter.String("ms.vss-testmanager-web")},
+	}
+
+	memberEntitlementClient.
+		EXPECT().
+		AddGroupEntitlement(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, args memberentitlementmanagement.AddGroupEntitlementArgs) (*memberentitlementmanagement.GroupEntitlementOperationReference, error) {
+			require.NotNil(t, args.GroupEntitlement.ProjectEntitlements)
+			require.Len(t, *args.GroupEntitlement.ProjectEntitlements, 1)
+			projectEntitlement := (*args.GroupEntitlement.ProjectEntitlements)[0]
+			require.Equal(t, projectID, *projectEntitlement.ProjectRef.Id)
+			require.Equal(t, groupType, *projectEntitlement.Group.GroupType)
+			require.NotNil(t, args.GroupEntitlement.ExtensionRules)
+			require.Equal(t, "ms.vss-testmanager-web", *(*args.GroupEntitlement.ExtensionRules)[0].Id)
+			return &memberentitlementmanagement.GroupEntitlementOperationReference{
+				Results: &[]memberentitlementmanagement.GroupOperationResult{
+					{IsSuccess: converter.Bool(true), Result: mockGroupEntitlement},
+				},
+			}, nil
+		}).
+		Times(1)
+
+	memberEntitlementClient.
+		EXPECT().
+		GetGroupEntitlement(gomock.Any(), memberentitlementmanagement.GetGroupEntitlementArgs{GroupId: &id}).
+		Return(mockGroupEntitlement, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGroupEntitlement().Schema, map[string]interface{}{
+		"display_name": "displayName",
+		"project_entitlement": []interface{}{
+			map[string]interface{}{"project_id": projectID.String(), "group_type": string(groupType)},
+		},
+		"extension": []interface{}{
+			map[string]interface{}{"id": "ms.vss-testmanager-web"},
+		},
+	})
+
+	err := resourceGroupEntitlementCreate(resourceData, clients)
+	require.Nil(t, err)
+
+	projects := resourceData.Get("project_entitlement").(*schema.Set).List()
+	require.Len(t, projects, 1)
+	require.Equal(t, string(groupType), projects[0].(map[string]interface{})["group_type"])
+	require.Equal(t, 1, resourceData.Get("extension").(*schema.Set).Len())
+}
+
 func getMockGroupEntitlement(id *uuid.UUID, accountLicenseType licensing.AccountLicenseType, origin string, originID string, principalName string, displayName string, descriptor string) *memberentitlementmanagement.GroupEntitlement {
 	subjectKind := "group"
 	licensingSource := licensing.LicensingSourceValues.Account
@@ -41,9 +41,19 @@ func ResourceServicePrincipalEntitlement() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"origin_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ExactlyOneOf: []string{"origin_id", "client_id", "display_name"},
+			},
+			"client_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				ExactlyOneOf: []string{"origin_id", "client_id", "display_name"},
 			},
 			"origin": {
 				Type:             schema.TypeString,
@@ -99,8 +109,13 @@ func ResourceServicePrincipalEntitlement() *schema.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 			"display_name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				ExactlyOneOf:     []string{"origin_id", "client_id", "display_name"},
 			},
 			"descriptor": {
 				Type:     schema.TypeString,
@@ -112,6 +127,17 @@ func ResourceServicePrincipalEntitlement() *schema.Resource {
 
 func resourceServicePrincipalEntitlementCreate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
+	if d.Get("origin_id").(string) == "" {
+		servicePrincipal, err := findServicePrincipal(clients, d.Get("client_id").(string), d.Get("display_name").(string))
+		if err != nil {
+			return fmt.Errorf("Creating service principal entitlement: %v", err)
+		}
+		d.Set("origin_id", converter.ToString(servicePrincipal.OriginId, ""))
+		d.Set("origin", converter.ToString(servicePrincipal.Origin, ""))
+		d.Set("display_name", converter.ToString(servicePrincipal.DisplayName, ""))
+		d.Set("descriptor", converter.ToString(servicePrincipal.Descriptor, ""))
+	}
+
 	servicePrincipalEntitlement := expandServicePrincipalEntitlement(d)
 	addedServicePrincipalEntitlement, err := addServicePrincipalEntitlement(clients, servicePrincipalEntitlement)
 	if err != nil {
@@ -241,6 +267,9 @@ func flattenServicePrincipalEntitlement(d *schema.ResourceData, servicePrincipal
 			if servicePrincipalEntitlement.ServicePrincipal.OriginId != nil {
 				d.Set("origin_id", *servicePrincipalEntitlement.ServicePrincipal.OriginId)
 			}
+			if servicePrincipalEntitlement.ServicePrincipal.ApplicationId != nil {
+				d.Set("client_id", *servicePrincipalEntitlement.ServicePrincipal.ApplicationId)
+			}
 			d.Set("display_name", *servicePrincipalEntitlement.ServicePrincipal.DisplayName)
 			d.Set("descriptor", *servicePrincipalEntitlement.ServicePrincipal.Descriptor)
 		}
@@ -270,6 +299,116 @@ func addServicePrincipalEntitlement(clients *client.AggregatedClient, servicePri
 	return servicePrincipalEntitlementsPostResponse.ServicePrincipalEntitlement, nil
 }
 
+// findServicePrincipal looks up a service principal or managed identity by its application (client) ID or, if
+// no client ID is given, by its display name. The lookup is resolved against Entra ID first, so that service
+// principals which are not known to the organization yet can be added. The service principals of the
+// organization are only searched if Entra ID returns no match.
+func findServicePrincipal(clients *client.AggregatedClient, clientID string, displayName string) (*graph.GraphServicePrincipal, error) {
+	lookup := fmt.Sprintf("display name %s", displayName)
+	if clientID != "" {
+		lookup = fmt.Sprintf("client ID %s", clientID)
+	}
+
+	matches, err := queryServicePrincipalSubjects(clients, clientID, displayName)
+	if err != nil {
+		log.Printf("[DEBUG] Resolving the service principal with %s in Entra ID failed, searching the organization: %v", lookup, err)
+	}
+	if len(matches) == 0 {
+		matches, err = listServicePrincipals(clients, clientID, displayName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("Could not find a service principal with %s", lookup)
+	}
+	if len(matches) > 1 {
+		return nil, fmt.Errorf("Found %d service principals with %s, use `origin_id` or `client_id` to select one of them", len(matches), lookup)
+	}
+	return &matches[0], nil
+}
+
+// queryServicePrincipalSubjects searches Entra ID for service principals or managed identities with the
+// application (client) ID or, if no client ID is given, with the display name
+func queryServicePrincipalSubjects(clients *client.AggregatedClient, clientID string, displayName string) ([]graph.GraphServicePrincipal, error) {
+	query := displayName
+	if clientID != "" {
+		query = clientID
+	}
+	subjects, err := clients.GraphClient.QuerySubjects(clients.Ctx, graph.QuerySubjectsArgs{
+		SubjectQuery: &graph.GraphSubjectQuery{
+			Query:       converter.String(query),
+			SubjectKind: &[]string{"ServicePrincipal"},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Querying service principals: %v", err)
+	}
+	if subjects == nil {
+		return nil, nil
+	}
+
+	var matches []graph.GraphServicePrincipal
+	for _, subject := range *subjects {
+		if !strings.EqualFold(converter.ToString(subject.SubjectKind, ""), "servicePrincipal") ||
+			converter.ToString(subject.OriginId, "") == "" {
+			continue
+		}
+		// Subjects carry no application ID, the query matches the client ID exactly
+		if clientID == "" && !strings.EqualFold(converter.ToString(subject.DisplayName, ""), displayName) {
+			continue
+		}
+		matches = append(matches, graph.GraphServicePrincipal{
+			Descriptor:  subject.Descriptor,
+			DisplayName: subject.DisplayName,
+			Origin:      subject.Origin,
+			OriginId:    subject.OriginId,
+			SubjectKind: subject.SubjectKind,
+		})
+	}
+	return matches, nil
+}
+
+// listServicePrincipals looks up the service principals or managed identities of the organization by their
+// application (client) ID or, if no client ID is given, by their display name
+func listServicePrincipals(clients *client.AggregatedClient, clientID string, displayName string) ([]graph.GraphServicePrincipal, error) {
+	var matches []graph.GraphServicePrincipal
+	var continuationToken *string
+	for {
+		result, err := clients.GraphClient.ListServicePrincipals(clients.Ctx, graph.ListServicePrincipalsArgs{
+			ContinuationToken: continuationToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Listing service principals: %v", err)
+		}
+		if result == nil {
+			break
+		}
+		if result.GraphServicePrincipals != nil {
+			for _, servicePrincipal := range *result.GraphServicePrincipals {
+				if servicePrincipal.IsDeletedInOrigin != nil && *servicePrincipal.IsDeletedInOrigin {
+					continue
+				}
+				if clientID != "" {
+					if strings.EqualFold(converter.ToString(servicePrincipal.ApplicationId, ""), clientID) {
+						matches = append(matches, servicePrincipal)
+					}
+				} else if strings.EqualFold(converter.ToString(servicePrincipal.DisplayName, ""), displayName) {
+					matches = append(matches, servicePrincipal)
+				}
+			}
+		}
+		if result.ContinuationToken == nil || len(*result.ContinuationToken) == 0 || (*result.ContinuationToken)[0] == "" {
+			break
+		}
+		token := (*result.ContinuationToken)[0]
+		continuationToken = &token
+	}
+
+	return matches, nil
+}
+
 func importServicePrincipalEntitlement(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	servicePrincipalEntitlementId := d.Id()
 	id, err := uuid.Parse(servicePrincipalEntitlementId)
@@ -69,6 +69,168 @@ func TestServicePrincipalEntitlement_CreateServicePrincipalEntitlement_WithOrigi
 	assert.Nil(t, err, "err should not be nil")
 }
 
+func TestServicePrincipalEntitlement_CreateServicePrincipalEntitlement_WithClientId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClient: memberEntitlementClient,
+		GraphClient:                   graphClient,
+		Ctx:                           context.Background(),
+	}
+
+	clientID := uuid.New().String()
+	originID := uuid.New().String()
+	id := uuid.New()
+	mockServicePrincipalEntitlement := getMockServicePrincipalEntitlement(&id, licensing.AccountLicenseTypeValues.Express, "aad", originID, "sp-test", "aadsp.sp-test")
+
+	token := "next"
+	graphClient.
+		EXPECT().
+		QuerySubjects(clients.Ctx, graph.QuerySubjectsArgs{
+			SubjectQuery: &graph.GraphSubjectQuery{
+				Query:       &clientID,
+				SubjectKind: &[]string{"ServicePrincipal"},
+			},
+		}).
+		Return(&[]graph.GraphSubject{}, nil).
+		Times(1)
+	graphClient.
+		EXPECT().
+		ListServicePrincipals(clients.Ctx, graph.ListServicePrincipalsArgs{}).
+		Return(&graph.PagedGraphServicePrincipals{
+			ContinuationToken: &[]string{token},
+			GraphServicePrincipals: &[]graph.GraphServicePrincipal{
+				{OriginId: converter.String(uuid.New().String()), ApplicationId: converter.String(uuid.New().String()), DisplayName: converter.String("other")},
+			},
+		}, nil).
+		Times(1)
+	graphClient.
+		EXPECT().
+		ListServicePrincipals(clients.Ctx, graph.ListServicePrincipalsArgs{ContinuationToken: &token}).
+		Return(&graph.PagedGraphServicePrincipals{
+			GraphServicePrincipals: &[]graph.GraphServicePrincipal{
+				{Origin: converter.String("aad"), OriginId: &originID, ApplicationId: &clientID, DisplayName: converter.String("sp-test"), Descriptor: converter.String("aadsp.sp-test")},
+			},
+		}, nil).
+		Times(1)
+
+	memberEntitlementClient.
+		EXPECT().
+		AddServicePrincipalEntitlement(gomock.Any(), MatchAddServicePrincipalEntitlementArgs(t, memberentitlementmanagement.AddServicePrincipalEntitlementArgs{
+			ServicePrincipalEntitlement: mockServicePrincipalEntitlement,
+		})).
+		Return(&memberentitlementmanagement.ServicePrincipalEntitlementsPostResponse{
+			IsSuccess:                   converter.Bool(true),
+			ServicePrincipalEntitlement: mockServicePrincipalEntitlement,
+		}, nil).
+		Times(1)
+	memberEntitlementClient.
+		EXPECT().
+		GetServicePrincipalEntitlement(gomock.Any(), memberentitlementmanagement.GetServicePrincipalEntitlementArgs{
+			ServicePrincipalId: &id,
+		}).
+		Return(mockServicePrincipalEntitlement, nil)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServicePrincipalEntitlement().Schema, nil)
+	resourceData.Set("client_id", clientID)
+
+	err := resourceServicePrincipalEntitlementCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, id.String(), resourceData.Id())
+	require.Equal(t, originID, resourceData.Get("origin_id"))
+}
+
+func TestServicePrincipalEntitlement_CreateServicePrincipalEntitlement_WithDisplayNameFromEntraID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClient: memberEntitlementClient,
+		GraphClient:                   graphClient,
+		Ctx:                           context.Background(),
+	}
+
+	originID := uuid.New().String()
+	id := uuid.New()
+	mockServicePrincipalEntitlement := getMockServicePrincipalEntitlement(&id, licensing.AccountLicenseTypeValues.Express, "aad", originID, "sp-test", "aadsp.sp-test")
+
+	graphClient.
+		EXPECT().
+		QuerySubjects(clients.Ctx, graph.QuerySubjectsArgs{
+			SubjectQuery: &graph.GraphSubjectQuery{
+				Query:       converter.String("sp-test"),
+				SubjectKind: &[]string{"ServicePrincipal"},
+			},
+		}).
+		Return(&[]graph.GraphSubject{
+			{SubjectKind: converter.String("group"), OriginId: converter.String(uuid.New().String()), DisplayName: converter.String("sp-test")},
+			{SubjectKind: converter.String("servicePrincipal"), Origin: converter.String("aad"), OriginId: &originID, DisplayName: converter.String("sp-test"), Descriptor: converter.String("aadsp.sp-test")},
+		}, nil).
+		Times(1)
+	graphClient.
+		EXPECT().
+		ListServicePrincipals(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	memberEntitlementClient.
+		EXPECT().
+		AddServicePrincipalEntitlement(gomock.Any(), MatchAddServicePrincipalEntitlementArgs(t, memberentitlementmanagement.AddServicePrincipalEntitlementArgs{
+			ServicePrincipalEntitlement: mockServicePrincipalEntitlement,
+		})).
+		Return(&memberentitlementmanagement.ServicePrincipalEntitlementsPostResponse{
+			IsSuccess:                   converter.Bool(true),
+			ServicePrincipalEntitlement: mockServicePrincipalEntitlement,
+		}, nil).
+		Times(1)
+	memberEntitlementClient.
+		EXPECT().
+		GetServicePrincipalEntitlement(gomock.Any(), memberentitlementmanagement.GetServicePrincipalEntitlementArgs{
+			ServicePrincipalId: &id,
+		}).
+		Return(mockServicePrincipalEntitlement, nil)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServicePrincipalEntitlement().Schema, nil)
+	resourceData.Set("display_name", "sp-test")
+
+	err := resourceServicePrincipalEntitlementCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, id.String(), resourceData.Id())
+	require.Equal(t, originID, resourceData.Get("origin_id"))
+}
+
+func TestServicePrincipalEntitlement_CreateServicePrincipalEntitlement_WithAmbiguousDisplayName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{
+		GraphClient: graphClient,
+		Ctx:         context.Background(),
+	}
+
+	graphClient.
+		EXPECT().
+		QuerySubjects(clients.Ctx, gomock.Any()).
+		Return(&[]graph.GraphSubject{
+			{SubjectKind: converter.String("servicePrincipal"), OriginId: converter.String(uuid.New().String()), DisplayName: converter.String("sp-test")},
+			{SubjectKind: converter.String("servicePrincipal"), OriginId: converter.String(uuid.New().String()), DisplayName: converter.String("SP-Test")},
+			{SubjectKind: converter.String("servicePrincipal"), OriginId: converter.String(uuid.New().String()), DisplayName: converter.String("sp-test-2")},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServicePrincipalEntitlement().Schema, nil)
+	resourceData.Set("display_name", "sp-test")
+
+	err := resourceServicePrincipalEntitlementCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Found 2 service principals with display name sp-test")
+}
+
 func TestServicePrincipalEntitlement_CreateServicePrincipalEntitlement_WithError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -113,6 +113,8 @@ func ResourceUserEntitlement() *schema.Resource {
 				}, true),
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
+			"project_entitlement": projectEntitlementSchema(),
+			"extension":           extensionSchema(),
 			"descriptor": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -176,25 +178,37 @@ func resourceUserEntitlementUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Reading account licensing source for UserEntitlementID: %s", userEntitlementID)
 	}
 
+	document := []webapi.JsonPatchOperation{
+		{
+			Op:   &webapi.OperationValues.Replace,
+			From: nil,
+			Path: converter.String("/accessLevel"),
+			Value: struct {
+				AccountLicenseType string `json:"accountLicenseType"`
+				LicensingSource    string `json:"licensingSource"`
+			}{
+				string(*accountLicenseType),
+				licensingSource.(string),
+			},
+		},
+	}
+
+	oldProjects, newProjects := d.GetChange("project_entitlement")
+	projectOperations, err := projectEntitlementOperations(oldProjects.(*schema.Set), newProjects.(*schema.Set))
+	if err != nil {
+		return fmt.Errorf("Updating user entitlement: %v", err)
+	}
+	document = append(document, projectOperations...)
+
+	oldExtensions, newExtensions := d.GetChange("extension")
+	document = append(document, extensionOperations("/extensions", oldExtensions.(*schema.Set), newExtensions.(*schema.Set))...)
+
 	clients := m.(*client.AggregatedClient)
 
 	patchResponse, err := clients.MemberEntitleManagementClient.UpdateUserEntitlement(clients.Ctx,
 		memberentitlementmanagement.UpdateUserEntitlementArgs{
-			UserId: &id,
-			Document: &[]webapi.JsonPatchOperation{
-				{
-					Op:   &webapi.OperationValues.Replace,
-					From: nil,
-					Path: converter.String("/accessLevel"),
-					Value: struct {
-						AccountLicenseType string `json:"accountLicenseType"`
-						LicensingSource    string `json:"licensingSource"`
-					}{
-						string(*accountLicenseType),
-						licensingSource.(string),
-					},
-				},
-			},
+			UserId:   &id,
+			Document: &document,
 		})
 	if err != nil {
 		return fmt.Errorf("Updating user entitlement: %v", err)
@@ -243,11 +257,18 @@ func expandUserEntitlement(d *schema.ResourceData) (*memberentitlementmanagement
 		return nil, err
 	}
 
+	projectEntitlements, err := expandProjectEntitlements(d.Get("project_entitlement").(*schema.Set))
+	if err != nil {
+		return nil, err
+	}
+
 	return &memberentitlementmanagement.UserEntitlement{
 		AccessLevel: &licensing.AccessLevel{
 			AccountLicenseType: accountLicenseType,
 			LicensingSource:    licensingSource,
 		},
+		ProjectEntitlements: projectEntitlements,
+		Extensions:          expandExtensions(d.Get("extension").(*schema.Set)),
 
 		// TODO check if it works in both case for GitHub and AzureDevOps
 		User: &graph.GraphUser{
@@ -268,6 +289,8 @@ func flattenUserEntitlement(d *schema.ResourceData, userEntitlement *memberentit
 	d.Set("principal_name", *userEntitlement.User.PrincipalName)
 	d.Set("account_license_type", string(*userEntitlement.AccessLevel.AccountLicenseType))
 	d.Set("licensing_source", *userEntitlement.AccessLevel.LicensingSource)
+	d.Set("project_entitlement", flattenProjectEntitlements(d.Get("project_entitlement").(*schema.Set), userEntitlement.ProjectEntitlements))
+	d.Set("extension", flattenExtensions(d.Get("extension").(*schema.Set), userEntitlement.Extensions))
 }
 
 func addUserEntitlement(clients *client.AggregatedClient, userEntitlement *memberentitlementmanagement.UserEntitlement) (*memberentitlementmanagement.UserEntitlement, error) {
@@ -299,25 +322,32 @@ func importUserEntitlement(d *schema.ResourceData, m interface{}) ([]*schema.Res
 			return nil, fmt.Errorf("Only UUID and UPN values can used for import [%s]", upn)
 		}
 
-		clients := m.(*client.AggregatedClient)
-		result, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
-			SearchFilter: converter.String("General"),
-			FilterValue:  &upn,
-		})
+		id, err := readUserEntitlementID(m.(*client.AggregatedClient), upn)
 		if err != nil {
 			return nil, err
 		}
+		d.SetId(id)
+	}
+	return []*schema.ResourceData{d}, nil
+}
 
-		if result == nil || len(*result) == 0 {
-			return nil, fmt.Errorf("No entitlement found for [%s]", upn)
-		}
-		if len(*result) > 1 {
-			return nil, fmt.Errorf("More than one entitle found for [%s]", upn)
-		}
+// readUserEntitlementID resolves the ID of the user with the given principal name
+func readUserEntitlementID(clients *client.AggregatedClient, principalName string) (string, error) {
+	result, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		SearchFilter: converter.String("General"),
+		FilterValue:  converter.String(principalName),
+	})
+	if err != nil {
+		return "", err
+	}
 
-		d.SetId((*result)[0].Id.String())
+	if result == nil || len(*result) == 0 {
+		return "", fmt.Errorf("No entitlement found for [%s]", principalName)
 	}
-	return []*schema.ResourceData{d}, nil
+	if len(*result) > 1 {
+		return "", fmt.Errorf("More than one entitle found for [%s]", principalName)
+	}
+	return (*result)[0].Id.String(), nil
 }
 
 func getUserEntitlementAPIErrorMessage(operationResults *[]memberentitlementmanagement.UserEntitlementOperationResult) string {
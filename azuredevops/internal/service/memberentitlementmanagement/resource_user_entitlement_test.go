@@ -11,7 +11,9 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/accounts"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/licensing"
@@ -552,6 +554,82 @@ func TestUserEntitlement_Update_TestEmptyErrors(t *testing.T) {
 	assert.Contains(t, err.Error(), "Unknown API error")
 }
 
+// TestUserEntitlement_Update_ProjectAndExtensionEntitlements verifies that changed project entitlements and
+// extensions are added and removed projects and extensions are removed
+func TestUserEntitlement_Update_ProjectAndExtensionEntitlements(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClient: memberEntitlementClient,
+		Ctx:                           context.Background(),
+	}
+
+	id := uuid.New()
+	changedProjectID := uuid.New().String()
+	removedProjectID := uuid.New().String()
+	mockUserEntitlement := getMockUserEntitlement(&id, licensing.AccountLicenseTypeValues.Express, "", "", "foobar@microsoft.com", "baz")
+	mockUserEntitlement.AccessLevel.Status = &accounts.AccountUserStatusValues.Active
+
+	resourceSchema := ResourceUserEntitlement().Schema
+	previous := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"principal_name": "foobar@microsoft.com",
+		"project_entitlement": []interface{}{
+			map[string]interface{}{"project_id": changedProjectID, "group_type": "projectReader"},
+			map[string]interface{}{"project_id": removedProjectID, "group_type": "projectReader"},
+		},
+		"extension": []interface{}{
+			map[string]interface{}{"id": "ms.vss-testmanager-web"},
+		},
+	})
+	previous.SetId(id.String())
+	state := previous.State()
+	diff, err := schema.InternalMap(resourceSchema).Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"principal_name": "foobar@microsoft.com",
+		"project_entitlement": []interface{}{
+			map[string]interface{}{"project_id": changedProjectID, "group_type": "projectAdministrator"},
+		},
+		"extension": []interface{}{
+			map[string]interface{}{"id": "ms.feed"},
+		},
+	}), nil, nil, true)
+	require.Nil(t, err)
+	resourceData, err := schema.InternalMap(resourceSchema).Data(state, diff)
+	require.Nil(t, err)
+
+	memberEntitlementClient.
+		EXPECT().
+		UpdateUserEntitlement(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, args memberentitlementmanagement.UpdateUserEntitlementArgs) (*memberentitlementmanagement.UserEntitlementsPatchResponse, error) {
+			paths := map[string]webapi.Operation{}
+			for _, operation := range *args.Document {
+				paths[*operation.Path] = *operation.Op
+			}
+			require.Equal(t, map[string]webapi.Operation{
+				"/accessLevel": webapi.OperationValues.Replace,
+				"/projectEntitlements/" + changedProjectID: webapi.OperationValues.Add,
+				"/projectEntitlements/" + removedProjectID: webapi.OperationValues.Remove,
+				"/extensions/ms.feed":                      webapi.OperationValues.Add,
+				"/extensions/ms.vss-testmanager-web":       webapi.OperationValues.Remove,
+			}, paths)
+			return &memberentitlementmanagement.UserEntitlementsPatchResponse{
+				IsSuccess:       converter.Bool(true),
+				UserEntitlement: mockUserEntitlement,
+			}, nil
+		}).
+		Times(1)
+
+	memberEntitlementClient.
+		EXPECT().
+		GetUserEntitlement(gomock.Any(), memberentitlementmanagement.GetUserEntitlementArgs{UserId: &id}).
+		Return(mockUserEntitlement, nil).
+		Times(1)
+
+	err = resourceUserEntitlementUpdate(resourceData, clients)
+	require.Nil(t, err)
+}
+
 func getMockUserEntitlement(id *uuid.UUID, accountLicenseType licensing.AccountLicenseType, origin string, originID string, principalName string, descriptor string) *memberentitlementmanagement.UserEntitlement {
 	subjectKind := "user"
 	licensingSource := licensing.LicensingSourceValues.Account
@@ -0,0 +1,772 @@
+package memberentitlementmanagement
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/licensing"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/operations"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// userEntitlementsMaxRetries is the number of times a throttled batch is retried before the users of the
+// batch are reported as throttled
+const userEntitlementsMaxRetries = 3
+
+// userEntitlementsRetryDelay is the initial delay between retries of a throttled batch, it is doubled on
+// every retry
+var userEntitlementsRetryDelay = 10 * time.Second
+
+// ResourceUserEntitlements schema and implementation for the bulk user entitlements resource
+func ResourceUserEntitlements() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUserEntitlementsCreate,
+		Read:   resourceUserEntitlementsRead,
+		Update: resourceUserEntitlementsUpdate,
+		Delete: resourceUserEntitlementsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		CustomizeDiff: resourceUserEntitlementsCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"account_license_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(licensing.AccountLicenseTypeValues.Express),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(licensing.AccountLicenseTypeValues.Advanced),
+								string(licensing.AccountLicenseTypeValues.EarlyAdopter),
+								string(licensing.AccountLicenseTypeValues.Express),
+								"basic",
+								string(licensing.AccountLicenseTypeValues.None),
+								string(licensing.AccountLicenseTypeValues.Professional),
+								string(licensing.AccountLicenseTypeValues.Stakeholder),
+							}, true),
+						},
+						"licensing_source": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(licensing.LicensingSourceValues.Account),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(licensing.LicensingSourceValues.None),
+								string(licensing.LicensingSourceValues.Account),
+								string(licensing.LicensingSourceValues.Msdn),
+								string(licensing.LicensingSourceValues.Profile),
+								string(licensing.LicensingSourceValues.Auto),
+								string(licensing.LicensingSourceValues.Trial),
+							}, true),
+						},
+						"project_entitlement": projectEntitlementSchema(),
+					},
+				},
+			},
+			"do_not_send_invite_for_new_users": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      20,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"user_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"changed_users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"throttled_users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"failed_users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type userEntitlementItem struct {
+	principalName       string
+	accountLicenseType  string
+	licensingSource     string
+	projectEntitlements []projectEntitlementItem
+}
+
+type projectEntitlementItem struct {
+	projectID string
+	groupType string
+}
+
+// userEntitlementsReport collects the outcome of an apply per principal name
+type userEntitlementsReport struct {
+	changed   []string
+	throttled []string
+	failed    map[string]string
+}
+
+func newUserEntitlementsReport() *userEntitlementsReport {
+	return &userEntitlementsReport{failed: map[string]string{}}
+}
+
+func (r *userEntitlementsReport) succeeded(principalName string) bool {
+	if _, ok := r.failed[principalName]; ok {
+		return false
+	}
+	for _, throttled := range r.throttled {
+		if throttled == principalName {
+			return false
+		}
+	}
+	return true
+}
+
+func resourceUserEntitlementsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("user") {
+		return nil
+	}
+	for _, key := range []string{"user_ids", "changed_users", "throttled_users", "failed_users"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceUserEntitlementsCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	items := expandUserEntitlementItems(d.Get("user").(*schema.Set))
+	ids := map[string]string{}
+	report := applyUserEntitlements(clients, items, nil, ids, d.Get("batch_size").(int), d.Get("do_not_send_invite_for_new_users").(bool), d.Timeout(schema.TimeoutCreate))
+
+	d.SetId(uuid.New().String())
+	if err := setUserEntitlementsState(d, items, nil, nil, ids, report); err != nil {
+		return err
+	}
+	return resourceUserEntitlementsRead(d, m)
+}
+
+func resourceUserEntitlementsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	ids := expandUserEntitlementIDs(d)
+	items := expandUserEntitlementItems(d.Get("user").(*schema.Set))
+
+	userEntitlements := map[string]*memberentitlementmanagement.UserEntitlement{}
+	if len(ids) > 0 {
+		result, err := searchUserEntitlements(clients, "",
+			memberentitlementmanagement.UserEntitlementPropertyValues.License,
+			memberentitlementmanagement.UserEntitlementPropertyValues.Projects)
+		if err != nil {
+			return fmt.Errorf("Reading user entitlements: %v", err)
+		}
+		for i := range result {
+			if result[i].Id != nil {
+				userEntitlements[strings.ToLower(result[i].Id.String())] = &result[i]
+			}
+		}
+	}
+
+	users := make([]interface{}, 0, len(items))
+	currentIDs := map[string]interface{}{}
+	for _, item := range items {
+		id, ok := ids[userEntitlementKey(item.principalName)]
+		if !ok {
+			continue
+		}
+
+		userEntitlement, ok := userEntitlements[strings.ToLower(id)]
+		if !ok {
+			log.Printf("[INFO] User entitlement of %s not found. Removing from state", item.principalName)
+			continue
+		}
+		if userEntitlement.AccessLevel == nil || userEntitlement.AccessLevel.Status == nil || isUserDeleted(userEntitlement) {
+			log.Printf("[INFO] User %s has been deleted. Removing from state", item.principalName)
+			continue
+		}
+
+		users = append(users, flattenUserEntitlementItem(item, userEntitlement))
+		currentIDs[item.principalName] = id
+	}
+
+	if err := d.Set("user", users); err != nil {
+		return fmt.Errorf("Setting user: %v", err)
+	}
+	return d.Set("user_ids", currentIDs)
+}
+
+func resourceUserEntitlementsUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	oldSet, newSet := d.GetChange("user")
+	oldItems := expandUserEntitlementItems(oldSet.(*schema.Set))
+	newItems := expandUserEntitlementItems(newSet.(*schema.Set))
+	changedItems := expandUserEntitlementItems(newSet.(*schema.Set).Difference(oldSet.(*schema.Set)))
+
+	ids := expandUserEntitlementIDs(d)
+	report := applyUserEntitlements(clients, changedItems, oldItems, ids, d.Get("batch_size").(int), d.Get("do_not_send_invite_for_new_users").(bool), d.Timeout(schema.TimeoutUpdate))
+
+	var removed []userEntitlementItem
+	for _, item := range oldItems {
+		if findUserEntitlementItem(newItems, item.principalName) == nil {
+			removed = append(removed, item)
+		}
+	}
+	removeUserEntitlements(clients, removed, ids, report)
+
+	if err := setUserEntitlementsState(d, newItems, oldItems, removed, ids, report); err != nil {
+		return err
+	}
+	return resourceUserEntitlementsRead(d, m)
+}
+
+func resourceUserEntitlementsDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	items := expandUserEntitlementItems(d.Get("user").(*schema.Set))
+	ids := expandUserEntitlementIDs(d)
+	report := newUserEntitlementsReport()
+	removeUserEntitlements(clients, items, ids, report)
+
+	if len(report.failed) > 0 {
+		return fmt.Errorf("Deleting user entitlements: %s", formatFailedUserEntitlements(report.failed))
+	}
+	return nil
+}
+
+// applyUserEntitlements applies the entitlements of the users in batches. Users which cannot be applied are
+// recorded in the report instead of aborting the remaining batches. The IDs of new users are added to ids.
+// The project entitlements of previous, which are not part of the users anymore, are removed.
+func applyUserEntitlements(clients *client.AggregatedClient, items []userEntitlementItem, previous []userEntitlementItem, ids map[string]string, batchSize int, doNotSendInvite bool, timeout time.Duration) *userEntitlementsReport {
+	report := newUserEntitlementsReport()
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	for start := 0; start < len(items); start += batchSize {
+		end := start + batchSize
+		if end > len(items) {
+			end = len(items)
+		}
+		batch := items[start:end]
+
+		var document []webapi.JsonPatchOperation
+		for _, item := range batch {
+			ops, err := buildUserEntitlementOperations(item, findUserEntitlementItem(previous, item.principalName), ids[userEntitlementKey(item.principalName)])
+			if err != nil {
+				report.failed[item.principalName] = err.Error()
+				continue
+			}
+			document = append(document, ops...)
+		}
+		if len(document) == 0 {
+			continue
+		}
+
+		operationReference, err := updateUserEntitlementsWithRetry(clients, document, doNotSendInvite)
+		if err != nil {
+			for _, item := range batch {
+				if _, ok := report.failed[item.principalName]; ok {
+					continue
+				}
+				if utils.ResponseWasStatusCode(err, 429) {
+					report.throttled = append(report.throttled, item.principalName)
+				} else {
+					report.failed[item.principalName] = err.Error()
+				}
+			}
+			continue
+		}
+
+		if err := waitForUserEntitlementsOperation(clients, operationReference, timeout); err != nil {
+			for _, item := range batch {
+				if _, ok := report.failed[item.principalName]; !ok {
+					report.failed[item.principalName] = err.Error()
+				}
+			}
+			continue
+		}
+
+		classifyUserEntitlementResults(clients, batch, operationReference, ids, report)
+	}
+	return report
+}
+
+func updateUserEntitlementsWithRetry(clients *client.AggregatedClient, document []webapi.JsonPatchOperation, doNotSendInvite bool) (*memberentitlementmanagement.UserEntitlementOperationReference, error) {
+	delay := userEntitlementsRetryDelay
+	for attempt := 0; ; attempt++ {
+		operationReference, err := clients.MemberEntitleManagementClient.UpdateUserEntitlements(clients.Ctx, memberentitlementmanagement.UpdateUserEntitlementsArgs{
+			Document:                   &document,
+			DoNotSendInviteForNewUsers: converter.Bool(doNotSendInvite),
+		})
+		if err == nil || !utils.ResponseWasStatusCode(err, 429) || attempt >= userEntitlementsMaxRetries {
+			return operationReference, err
+		}
+		log.Printf("[DEBUG] Updating user entitlements was throttled, retrying in %s", delay)
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+// waitForUserEntitlementsOperation waits for an operation, which has not been completed with the response
+// of the batch request
+func waitForUserEntitlementsOperation(clients *client.AggregatedClient, operationReference *memberentitlementmanagement.UserEntitlementOperationReference, timeout time.Duration) error {
+	if operationReference == nil {
+		return fmt.Errorf("Updating user entitlements: the service returned no operation")
+	}
+	if converter.ToBool(operationReference.Completed, true) || operationReference.Id == nil {
+		return nil
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			string(operations.OperationStatusValues.InProgress),
+			string(operations.OperationStatusValues.Queued),
+			string(operations.OperationStatusValues.NotSet),
+		},
+		Target: []string{
+			string(operations.OperationStatusValues.Failed),
+			string(operations.OperationStatusValues.Succeeded),
+			string(operations.OperationStatusValues.Cancelled),
+		},
+		Refresh: func() (interface{}, string, error) {
+			operation, err := clients.OperationsClient.GetOperation(clients.Ctx, operations.GetOperationArgs{
+				OperationId: operationReference.Id,
+				PluginId:    operationReference.PluginId,
+			})
+			if err != nil {
+				return nil, string(operations.OperationStatusValues.Failed), err
+			}
+			return operation, string(*operation.Status), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 2 * time.Second,
+		Delay:      1 * time.Second,
+	}
+
+	result, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Waiting for user entitlements operation %s: %v", operationReference.Id.String(), err)
+	}
+	operation := result.(*operations.Operation)
+	if *operation.Status != operations.OperationStatusValues.Succeeded {
+		return fmt.Errorf("User entitlements operation %s finished with status %s. %s",
+			operationReference.Id.String(), *operation.Status, converter.ToString(operation.ResultMessage, ""))
+	}
+	operationReference.HaveResultsSucceeded = converter.Bool(true)
+	return nil
+}
+
+// classifyUserEntitlementResults records the result of every user of the batch in the report
+func classifyUserEntitlementResults(clients *client.AggregatedClient, batch []userEntitlementItem, operationReference *memberentitlementmanagement.UserEntitlementOperationReference, ids map[string]string, report *userEntitlementsReport) {
+	principalByID := map[string]string{}
+	for _, item := range batch {
+		if id, ok := ids[userEntitlementKey(item.principalName)]; ok {
+			principalByID[strings.ToLower(id)] = item.principalName
+		}
+	}
+
+	reported := map[string]bool{}
+	if operationReference.Results != nil {
+		for _, result := range *operationReference.Results {
+			principalName, id := userEntitlementResultPrincipal(result, principalByID)
+			item := findUserEntitlementItem(batch, principalName)
+			if item == nil {
+				continue
+			}
+			reported[item.principalName] = true
+			if id != "" {
+				ids[userEntitlementKey(item.principalName)] = id
+			}
+
+			if converter.ToBool(result.IsSuccess, false) {
+				continue
+			}
+			message := getUserEntitlementAPIErrorMessage(&[]memberentitlementmanagement.UserEntitlementOperationResult{result})
+			if strings.Contains(strings.ToLower(message), "throttl") {
+				report.throttled = append(report.throttled, item.principalName)
+			} else {
+				report.failed[item.principalName] = message
+			}
+		}
+	}
+
+	for _, item := range batch {
+		if _, ok := report.failed[item.principalName]; ok || !report.succeeded(item.principalName) {
+			continue
+		}
+		if !reported[item.principalName] && !converter.ToBool(operationReference.HaveResultsSucceeded, false) {
+			report.failed[item.principalName] = "The service returned no result for the user"
+			continue
+		}
+
+		key := userEntitlementKey(item.principalName)
+		if _, ok := ids[key]; !ok {
+			id, err := readUserEntitlementID(clients, item.principalName)
+			if err != nil {
+				report.failed[item.principalName] = err.Error()
+				continue
+			}
+			ids[key] = id
+		}
+		report.changed = append(report.changed, item.principalName)
+	}
+}
+
+// userEntitlementResultPrincipal returns the principal name and the ID of the user an operation result belongs to
+func userEntitlementResultPrincipal(result memberentitlementmanagement.UserEntitlementOperationResult, principalByID map[string]string) (string, string) {
+	id := ""
+	if result.UserId != nil && *result.UserId != uuid.Nil {
+		id = result.UserId.String()
+	}
+
+	if entitlement, ok := result.Result.(map[string]interface{}); ok {
+		if id == "" {
+			if value, ok := entitlement["id"].(string); ok {
+				id = value
+			}
+		}
+		if user, ok := entitlement["user"].(map[string]interface{}); ok {
+			if principalName, ok := user["principalName"].(string); ok && principalName != "" {
+				return principalName, id
+			}
+		}
+	}
+	return principalByID[strings.ToLower(id)], id
+}
+
+// buildUserEntitlementOperations returns the patch operations of a user. Existing users get the project
+// entitlements of previous removed, which are not part of the item anymore.
+func buildUserEntitlementOperations(item userEntitlementItem, previous *userEntitlementItem, id string) ([]webapi.JsonPatchOperation, error) {
+	accountLicenseType, err := converter.AccountLicenseType(item.accountLicenseType)
+	if err != nil {
+		return nil, err
+	}
+	licensingSource, err := converter.AccountLicensingSource(item.licensingSource)
+	if err != nil {
+		return nil, err
+	}
+	accessLevel := &licensing.AccessLevel{
+		AccountLicenseType: accountLicenseType,
+		LicensingSource:    licensingSource,
+	}
+
+	if id == "" {
+		projectEntitlements, err := expandProjectEntitlements(projectEntitlementItemSet(item.projectEntitlements))
+		if err != nil {
+			return nil, err
+		}
+		if projectEntitlements == nil {
+			projectEntitlements = &[]memberentitlementmanagement.ProjectEntitlement{}
+		}
+		return []webapi.JsonPatchOperation{
+			{
+				Op:   &webapi.OperationValues.Add,
+				Path: converter.String(""),
+				Value: memberentitlementmanagement.UserEntitlement{
+					AccessLevel: accessLevel,
+					User: &graph.GraphUser{
+						PrincipalName: converter.String(item.principalName),
+						SubjectKind:   converter.String("user"),
+					},
+					ProjectEntitlements: projectEntitlements,
+				},
+			},
+		}, nil
+	}
+
+	var previousProjectEntitlements []projectEntitlementItem
+	if previous != nil {
+		previousProjectEntitlements = previous.projectEntitlements
+	}
+	projectOperations, err := projectEntitlementOperations(projectEntitlementItemSet(previousProjectEntitlements), projectEntitlementItemSet(item.projectEntitlements))
+	if err != nil {
+		return nil, err
+	}
+
+	document := []webapi.JsonPatchOperation{
+		{
+			Op:    &webapi.OperationValues.Replace,
+			Path:  converter.String(fmt.Sprintf("/%s/accessLevel", id)),
+			Value: accessLevel,
+		},
+	}
+	for _, operation := range projectOperations {
+		operation.Path = converter.String(fmt.Sprintf("/%s%s", id, *operation.Path))
+		document = append(document, operation)
+	}
+	return document, nil
+}
+
+// projectEntitlementItemSet converts project entitlements to the set of the project_entitlement schema
+func projectEntitlementItemSet(items []projectEntitlementItem) *schema.Set {
+	set := schema.NewSet(schema.HashResource(projectEntitlementSchema().Elem.(*schema.Resource)), nil)
+	for _, item := range items {
+		set.Add(map[string]interface{}{
+			"project_id": item.projectID,
+			"group_type": item.groupType,
+		})
+	}
+	return set
+}
+
+// removeUserEntitlements removes the users from the organization. Users which cannot be removed are recorded
+// as failed in the report and remain in ids.
+func removeUserEntitlements(clients *client.AggregatedClient, items []userEntitlementItem, ids map[string]string, report *userEntitlementsReport) {
+	for _, item := range items {
+		key := userEntitlementKey(item.principalName)
+		id, ok := ids[key]
+		if !ok {
+			continue
+		}
+		userID, err := uuid.Parse(id)
+		if err != nil {
+			report.failed[item.principalName] = fmt.Sprintf("Parsing user entitlement ID %s: %v", id, err)
+			continue
+		}
+
+		err = clients.MemberEntitleManagementClient.DeleteUserEntitlement(clients.Ctx, memberentitlementmanagement.DeleteUserEntitlementArgs{
+			UserId: &userID,
+		})
+		if err != nil && !utils.ResponseWasNotFound(err) {
+			if utils.ResponseWasStatusCode(err, 429) {
+				report.throttled = append(report.throttled, item.principalName)
+			} else {
+				report.failed[item.principalName] = err.Error()
+			}
+			continue
+		}
+		delete(ids, key)
+		report.changed = append(report.changed, item.principalName)
+	}
+}
+
+// setUserEntitlementsState stores the desired users which have been applied. Users which have not been applied
+// keep their previous configuration, so that they show up in the next plan again.
+func setUserEntitlementsState(d *schema.ResourceData, desired []userEntitlementItem, previous []userEntitlementItem, removed []userEntitlementItem, ids map[string]string, report *userEntitlementsReport) error {
+	var state []userEntitlementItem
+	for _, item := range desired {
+		if report.succeeded(item.principalName) {
+			state = append(state, item)
+		} else if previousItem := findUserEntitlementItem(previous, item.principalName); previousItem != nil {
+			state = append(state, *previousItem)
+		}
+	}
+	for _, item := range removed {
+		if !report.succeeded(item.principalName) {
+			state = append(state, item)
+		}
+	}
+
+	users := make([]interface{}, 0, len(state))
+	stateIDs := map[string]interface{}{}
+	for _, item := range state {
+		users = append(users, flattenUserEntitlementItem(item, nil))
+		if id, ok := ids[userEntitlementKey(item.principalName)]; ok {
+			stateIDs[item.principalName] = id
+		}
+	}
+	if err := d.Set("user", users); err != nil {
+		return fmt.Errorf("Setting user: %v", err)
+	}
+	if err := d.Set("user_ids", stateIDs); err != nil {
+		return fmt.Errorf("Setting user_ids: %v", err)
+	}
+
+	sort.Strings(report.changed)
+	sort.Strings(report.throttled)
+	failedPrincipals := make([]string, 0, len(report.failed))
+	for principalName := range report.failed {
+		failedPrincipals = append(failedPrincipals, principalName)
+	}
+	sort.Strings(failedPrincipals)
+	failed := make([]interface{}, 0, len(failedPrincipals))
+	for _, principalName := range failedPrincipals {
+		log.Printf("[WARN] Applying the entitlement of %s failed: %s", principalName, report.failed[principalName])
+		failed = append(failed, map[string]interface{}{
+			"principal_name": principalName,
+			"error":          report.failed[principalName],
+		})
+	}
+
+	d.Set("changed_users", report.changed)
+	d.Set("throttled_users", report.throttled)
+	return d.Set("failed_users", failed)
+}
+
+func expandUserEntitlementItems(set *schema.Set) []userEntitlementItem {
+	if set == nil {
+		return []userEntitlementItem{}
+	}
+
+	items := make([]userEntitlementItem, 0, set.Len())
+	for _, raw := range set.List() {
+		user := raw.(map[string]interface{})
+		item := userEntitlementItem{
+			principalName:      user["principal_name"].(string),
+			accountLicenseType: user["account_license_type"].(string),
+			licensingSource:    user["licensing_source"].(string),
+		}
+		if projects, ok := user["project_entitlement"].(*schema.Set); ok {
+			for _, rawProject := range projects.List() {
+				project := rawProject.(map[string]interface{})
+				item.projectEntitlements = append(item.projectEntitlements, projectEntitlementItem{
+					projectID: project["project_id"].(string),
+					groupType: project["group_type"].(string),
+				})
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// flattenUserEntitlementItem converts a user to the schema representation. If the current entitlement is
+// available, the license and the declared project entitlements are reported as returned by the service,
+// preserving the configured values if they are equivalent.
+func flattenUserEntitlementItem(item userEntitlementItem, userEntitlement *memberentitlementmanagement.UserEntitlement) map[string]interface{} {
+	accountLicenseType := item.accountLicenseType
+	licensingSource := item.licensingSource
+	projects := make([]interface{}, 0, len(item.projectEntitlements))
+
+	if userEntitlement == nil {
+		for _, projectEntitlement := range item.projectEntitlements {
+			projects = append(projects, map[string]interface{}{
+				"project_id": projectEntitlement.projectID,
+				"group_type": projectEntitlement.groupType,
+			})
+		}
+	} else {
+		if userEntitlement.AccessLevel.AccountLicenseType != nil {
+			current := string(*userEntitlement.AccessLevel.AccountLicenseType)
+			if !equalAccountLicenseTypes(current, accountLicenseType) {
+				accountLicenseType = current
+			}
+		}
+		if userEntitlement.AccessLevel.LicensingSource != nil {
+			current := string(*userEntitlement.AccessLevel.LicensingSource)
+			if !strings.EqualFold(current, licensingSource) {
+				licensingSource = current
+			}
+		}
+
+		for _, projectEntitlement := range item.projectEntitlements {
+			current := findProjectEntitlement(userEntitlement.ProjectEntitlements, projectEntitlement.projectID)
+			if current == nil {
+				continue
+			}
+			groupType := projectEntitlement.groupType
+			if current.Group != nil && current.Group.GroupType != nil && !strings.EqualFold(string(*current.Group.GroupType), groupType) {
+				groupType = string(*current.Group.GroupType)
+			}
+			projects = append(projects, map[string]interface{}{
+				"project_id": projectEntitlement.projectID,
+				"group_type": groupType,
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"principal_name":       item.principalName,
+		"account_license_type": accountLicenseType,
+		"licensing_source":     licensingSource,
+		"project_entitlement":  projects,
+	}
+}
+
+// equalAccountLicenseTypes reports whether two license types are the same, the service returns the basic
+// license as express or earlyAdopter
+func equalAccountLicenseTypes(a string, b string) bool {
+	equalEntitlements := []string{
+		string(licensing.AccountLicenseTypeValues.EarlyAdopter),
+		string(licensing.AccountLicenseTypeValues.Express),
+		"basic",
+	}
+	inEqualEntitlements := func(v string) bool {
+		for _, str := range equalEntitlements {
+			if strings.EqualFold(v, str) {
+				return true
+			}
+		}
+		return false
+	}
+	return strings.EqualFold(a, b) || (inEqualEntitlements(a) && inEqualEntitlements(b))
+}
+
+func findUserEntitlementItem(items []userEntitlementItem, principalName string) *userEntitlementItem {
+	for i := range items {
+		if strings.EqualFold(items[i].principalName, principalName) {
+			return &items[i]
+		}
+	}
+	return nil
+}
+
+func expandUserEntitlementIDs(d *schema.ResourceData) map[string]string {
+	ids := map[string]string{}
+	for principalName, id := range d.Get("user_ids").(map[string]interface{}) {
+		ids[userEntitlementKey(principalName)] = id.(string)
+	}
+	return ids
+}
+
+func userEntitlementKey(principalName string) string {
+	return strings.ToLower(principalName)
+}
+
+func formatFailedUserEntitlements(failed map[string]string) string {
+	messages := make([]string, 0, len(failed))
+	for principalName, message := range failed {
+		messages = append(messages, fmt.Sprintf("%s: %s", principalName, message))
+	}
+	sort.Strings(messages)
+	return strings.Join(messages, "; ")
+}
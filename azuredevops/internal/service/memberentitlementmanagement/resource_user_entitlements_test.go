@@ -0,0 +1,342 @@
+//go:build (all || resource_user_entitlements) && !exclude_resource_user_entitlements
+// +build all resource_user_entitlements
+// +build !exclude_resource_user_entitlements
+
+package memberentitlementmanagement
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/accounts"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/licensing"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/memberentitlementmanagementextras"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUserEntitlements_Create_ReportsFailedUsersWithoutAborting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	extrasClient := azdosdkmocks.NewMockMemberentitlementmanagementextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClient: memberEntitlementClient,
+		MemberEntitleManagementExtras: extrasClient,
+		Ctx:                           context.Background(),
+	}
+
+	succeededID := uuid.New()
+	memberEntitlementClient.
+		EXPECT().
+		UpdateUserEntitlements(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args memberentitlementmanagement.UpdateUserEntitlementsArgs) (*memberentitlementmanagement.UserEntitlementOperationReference, error) {
+			require.Len(t, *args.Document, 2)
+			for _, operation := range *args.Document {
+				require.Equal(t, webapi.OperationValues.Add, *operation.Op)
+				require.Equal(t, "", *operation.Path)
+			}
+			return &memberentitlementmanagement.UserEntitlementOperationReference{
+				Completed:            converter.Bool(true),
+				HaveResultsSucceeded: converter.Bool(false),
+				Results: &[]memberentitlementmanagement.UserEntitlementOperationResult{
+					{
+						IsSuccess: converter.Bool(true),
+						UserId:    &succeededID,
+						Result: map[string]interface{}{
+							"id":   succeededID.String(),
+							"user": map[string]interface{}{"principalName": "ok@example.com"},
+						},
+					},
+					{
+						IsSuccess: converter.Bool(false),
+						Errors:    &[]azuredevops.KeyValuePair{userEntitlementsError("5000", "User does not exist")},
+						Result: map[string]interface{}{
+							"user": map[string]interface{}{"principalName": "missing@example.com"},
+						},
+					},
+				},
+			}, nil
+		}).
+		Times(1)
+
+	extrasClient.
+		EXPECT().
+		SearchUserEntitlements(clients.Ctx, gomock.Any()).
+		Return(&memberentitlementmanagementextras.PagedUserEntitlements{
+			Members: &[]memberentitlementmanagement.UserEntitlement{*getMockBulkUserEntitlement(succeededID, "ok@example.com")},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceUserEntitlements().Schema, map[string]interface{}{
+		"user": []interface{}{
+			map[string]interface{}{"principal_name": "ok@example.com"},
+			map[string]interface{}{"principal_name": "missing@example.com"},
+		},
+	})
+
+	err := resourceUserEntitlementsCreate(resourceData, clients)
+	require.Nil(t, err)
+
+	require.Equal(t, []interface{}{"ok@example.com"}, resourceData.Get("changed_users"))
+	failed := resourceData.Get("failed_users").([]interface{})
+	require.Len(t, failed, 1)
+	require.Equal(t, "missing@example.com", failed[0].(map[string]interface{})["principal_name"])
+	require.Contains(t, failed[0].(map[string]interface{})["error"], "User does not exist")
+
+	users := resourceData.Get("user").(*schema.Set).List()
+	require.Len(t, users, 1)
+	require.Equal(t, "ok@example.com", users[0].(map[string]interface{})["principal_name"])
+	require.Equal(t, map[string]interface{}{"ok@example.com": succeededID.String()}, resourceData.Get("user_ids"))
+}
+
+func TestUserEntitlements_Apply_RetriesThrottledBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	retryDelay := userEntitlementsRetryDelay
+	userEntitlementsRetryDelay = 0
+	defer func() { userEntitlementsRetryDelay = retryDelay }()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	extrasClient := azdosdkmocks.NewMockMemberentitlementmanagementextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClient: memberEntitlementClient,
+		MemberEntitleManagementExtras: extrasClient,
+		Ctx:                           context.Background(),
+	}
+
+	id := uuid.New()
+	throttled := memberEntitlementClient.
+		EXPECT().
+		UpdateUserEntitlements(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(429)}).
+		Times(1)
+	memberEntitlementClient.
+		EXPECT().
+		UpdateUserEntitlements(clients.Ctx, gomock.Any()).
+		Return(&memberentitlementmanagement.UserEntitlementOperationReference{
+			Completed:            converter.Bool(true),
+			HaveResultsSucceeded: converter.Bool(true),
+			Results: &[]memberentitlementmanagement.UserEntitlementOperationResult{
+				{IsSuccess: converter.Bool(true), UserId: &id},
+			},
+		}, nil).
+		After(throttled).
+		Times(1)
+	extrasClient.
+		EXPECT().
+		SearchUserEntitlements(clients.Ctx, gomock.Any()).
+		Return(&memberentitlementmanagementextras.PagedUserEntitlements{
+			Members: &[]memberentitlementmanagement.UserEntitlement{*getMockBulkUserEntitlement(id, "user@example.com")},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceUserEntitlements().Schema, map[string]interface{}{
+		"user": []interface{}{
+			map[string]interface{}{"principal_name": "user@example.com"},
+		},
+	})
+
+	report := applyUserEntitlements(clients, expandUserEntitlementItems(resourceData.Get("user").(*schema.Set)), nil,
+		map[string]string{"user@example.com": id.String()}, 20, false, time.Minute)
+	require.Empty(t, report.throttled)
+	require.Empty(t, report.failed)
+	require.Equal(t, []string{"user@example.com"}, report.changed)
+
+	resourceData.Set("user_ids", map[string]interface{}{"user@example.com": id.String()})
+	require.Nil(t, resourceUserEntitlementsRead(resourceData, clients))
+	require.Equal(t, 1, resourceData.Get("user").(*schema.Set).Len())
+}
+
+func TestUserEntitlements_Read_SearchesOnceAndMatchesUsersLocally(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	extrasClient := azdosdkmocks.NewMockMemberentitlementmanagementextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClient: memberEntitlementClient,
+		MemberEntitleManagementExtras: extrasClient,
+		Ctx:                           context.Background(),
+	}
+
+	activeID := uuid.New()
+	deletedID := uuid.New()
+	missingID := uuid.New()
+	deleted := getMockBulkUserEntitlement(deletedID, "deleted@example.com")
+	deletedStatus := accounts.AccountUserStatusValues.Deleted
+	deleted.AccessLevel.Status = &deletedStatus
+
+	memberEntitlementClient.
+		EXPECT().
+		GetUserEntitlement(gomock.Any(), gomock.Any()).
+		Times(0)
+	firstPage := extrasClient.
+		EXPECT().
+		SearchUserEntitlements(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args memberentitlementmanagement.SearchUserEntitlementsArgs) (*memberentitlementmanagementextras.PagedUserEntitlements, error) {
+			require.Nil(t, args.ContinuationToken)
+			require.Nil(t, args.Filter)
+			require.Equal(t, "license,projects", string(*args.Select))
+			return &memberentitlementmanagementextras.PagedUserEntitlements{
+				ContinuationToken: converter.String("next"),
+				Members:           &[]memberentitlementmanagement.UserEntitlement{*deleted},
+			}, nil
+		}).
+		Times(1)
+	extrasClient.
+		EXPECT().
+		SearchUserEntitlements(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args memberentitlementmanagement.SearchUserEntitlementsArgs) (*memberentitlementmanagementextras.PagedUserEntitlements, error) {
+			require.Equal(t, "next", *args.ContinuationToken)
+			return &memberentitlementmanagementextras.PagedUserEntitlements{
+				Members: &[]memberentitlementmanagement.UserEntitlement{*getMockBulkUserEntitlement(activeID, "active@example.com")},
+			}, nil
+		}).
+		After(firstPage).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceUserEntitlements().Schema, map[string]interface{}{
+		"user": []interface{}{
+			map[string]interface{}{"principal_name": "active@example.com"},
+			map[string]interface{}{"principal_name": "deleted@example.com"},
+			map[string]interface{}{"principal_name": "missing@example.com"},
+		},
+	})
+	resourceData.Set("user_ids", map[string]interface{}{
+		"active@example.com":  activeID.String(),
+		"deleted@example.com": deletedID.String(),
+		"missing@example.com": missingID.String(),
+	})
+
+	require.Nil(t, resourceUserEntitlementsRead(resourceData, clients))
+	users := resourceData.Get("user").(*schema.Set).List()
+	require.Len(t, users, 1)
+	require.Equal(t, "active@example.com", users[0].(map[string]interface{})["principal_name"])
+	require.Equal(t, map[string]interface{}{"active@example.com": activeID.String()}, resourceData.Get("user_ids"))
+}
+
+func TestUserEntitlements_Apply_ReportsThrottledUsersAfterRetries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	retryDelay := userEntitlementsRetryDelay
+	userEntitlementsRetryDelay = 0
+	defer func() { userEntitlementsRetryDelay = retryDelay }()
+
+	memberEntitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	clients := &client.AggregatedClient{
+		MemberEntitleManagementClient: memberEntitlementClient,
+		Ctx:                           context.Background(),
+	}
+
+	memberEntitlementClient.
+		EXPECT().
+		UpdateUserEntitlements(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(429)}).
+		Times(userEntitlementsMaxRetries + 1)
+
+	items := []userEntitlementItem{
+		{principalName: "a@example.com", accountLicenseType: "express", licensingSource: "account"},
+		{principalName: "b@example.com", accountLicenseType: "express", licensingSource: "account"},
+	}
+	report := applyUserEntitlements(clients, items, nil, map[string]string{}, 20, false, time.Minute)
+	require.Equal(t, []string{"a@example.com", "b@example.com"}, report.throttled)
+	require.Empty(t, report.failed)
+	require.Empty(t, report.changed)
+}
+
+func TestUserEntitlements_BuildOperations_ExistingUser(t *testing.T) {
+	id := uuid.New().String()
+	projectID := uuid.New().String()
+
+	document, err := buildUserEntitlementOperations(userEntitlementItem{
+		principalName:      "user@example.com",
+		accountLicenseType: "stakeholder",
+		licensingSource:    "account",
+		projectEntitlements: []projectEntitlementItem{
+			{projectID: projectID, groupType: "projectReader"},
+		},
+	}, nil, id)
+	require.Nil(t, err)
+	require.Len(t, document, 2)
+
+	require.Equal(t, webapi.OperationValues.Replace, *document[0].Op)
+	require.Equal(t, "/"+id+"/accessLevel", *document[0].Path)
+	accessLevel := document[0].Value.(*licensing.AccessLevel)
+	require.Equal(t, licensing.AccountLicenseTypeValues.Stakeholder, *accessLevel.AccountLicenseType)
+
+	require.Equal(t, webapi.OperationValues.Add, *document[1].Op)
+	require.Equal(t, "/"+id+"/projectEntitlements/"+projectID, *document[1].Path)
+	projectEntitlement := document[1].Value.(memberentitlementmanagement.ProjectEntitlement)
+	require.Equal(t, memberentitlementmanagement.GroupTypeValues.ProjectReader, *projectEntitlement.Group.GroupType)
+}
+
+func TestUserEntitlements_BuildOperations_RemovesDroppedProjectEntitlements(t *testing.T) {
+	id := uuid.New().String()
+	keptProjectID := uuid.New().String()
+	removedProjectID := uuid.New().String()
+
+	previous := userEntitlementItem{
+		principalName:      "user@example.com",
+		accountLicenseType: "express",
+		licensingSource:    "account",
+		projectEntitlements: []projectEntitlementItem{
+			{projectID: keptProjectID, groupType: "projectReader"},
+			{projectID: removedProjectID, groupType: "projectContributor"},
+		},
+	}
+	document, err := buildUserEntitlementOperations(userEntitlementItem{
+		principalName:      "user@example.com",
+		accountLicenseType: "express",
+		licensingSource:    "account",
+		projectEntitlements: []projectEntitlementItem{
+			{projectID: keptProjectID, groupType: "projectAdministrator"},
+		},
+	}, &previous, id)
+	require.Nil(t, err)
+	require.Len(t, document, 3)
+
+	require.Equal(t, webapi.OperationValues.Replace, *document[0].Op)
+	require.Equal(t, webapi.OperationValues.Add, *document[1].Op)
+	require.Equal(t, "/"+id+"/projectEntitlements/"+keptProjectID, *document[1].Path)
+	projectEntitlement := document[1].Value.(memberentitlementmanagement.ProjectEntitlement)
+	require.Equal(t, memberentitlementmanagement.GroupTypeValues.ProjectAdministrator, *projectEntitlement.Group.GroupType)
+	require.Equal(t, webapi.OperationValues.Remove, *document[2].Op)
+	require.Equal(t, "/"+id+"/projectEntitlements/"+removedProjectID, *document[2].Path)
+}
+
+func userEntitlementsError(key string, value string) azuredevops.KeyValuePair {
+	k := interface{}(key)
+	v := interface{}(value)
+	return azuredevops.KeyValuePair{Key: &k, Value: &v}
+}
+
+func getMockBulkUserEntitlement(id uuid.UUID, principalName string) *memberentitlementmanagement.UserEntitlement {
+	accountLicenseType := licensing.AccountLicenseTypeValues.Express
+	licensingSource := licensing.LicensingSourceValues.Account
+	status := accounts.AccountUserStatusValues.Active
+	return &memberentitlementmanagement.UserEntitlement{
+		Id: &id,
+		AccessLevel: &licensing.AccessLevel{
+			AccountLicenseType: &accountLicenseType,
+			LicensingSource:    &licensingSource,
+			Status:             &status,
+		},
+		User: &graph.GraphUser{
+			PrincipalName: converter.String(principalName),
+		},
+	}
+}
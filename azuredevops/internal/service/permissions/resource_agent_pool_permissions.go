@@ -0,0 +1,129 @@
+package permissions
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+)
+
+// ResourceAgentPoolPermissions schema and implementation for agent pool and agent queue permission resource
+func ResourceAgentPoolPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAgentPoolPermissionsCreateOrUpdate,
+		Read:   resourceAgentPoolPermissionsRead,
+		Update: resourceAgentPoolPermissionsCreateOrUpdate,
+		Delete: resourceAgentPoolPermissionsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		CustomizeDiff: securityhelper.ValidatePermissionNames(securityhelper.SecurityNamespaceIDValues.DistributedTask),
+		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
+			"agent_pool_id": {
+				Type:          schema.TypeInt,
+				ValidateFunc:  validation.IntAtLeast(1),
+				ForceNew:      true,
+				Optional:      true,
+				ConflictsWith: []string{"project_id", "agent_queue_id"},
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				ForceNew:     true,
+				Optional:     true,
+			},
+			"agent_queue_id": {
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+				ForceNew:     true,
+				Optional:     true,
+				RequiredWith: []string{"project_id"},
+			},
+		}),
+	}
+}
+
+func resourceAgentPoolPermissionsCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.DistributedTask, createAgentPoolToken)
+	if err != nil {
+		return err
+	}
+
+	if err := securityhelper.SetPrincipalPermissions(d, sn, nil, false); err != nil {
+		return err
+	}
+
+	return resourceAgentPoolPermissionsRead(d, m)
+}
+
+func resourceAgentPoolPermissionsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.DistributedTask, createAgentPoolToken)
+	if err != nil {
+		return err
+	}
+
+	principalPermissions, err := securityhelper.GetPrincipalPermissions(d, sn)
+	if err != nil {
+		return err
+	}
+	if principalPermissions == nil {
+		d.SetId("")
+		log.Printf("[INFO] Permissions for ACL token %q not found. Removing from state", sn.GetToken())
+		return nil
+	}
+
+	d.Set("permissions", principalPermissions.Permissions)
+	return nil
+}
+
+func resourceAgentPoolPermissionsDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.DistributedTask, createAgentPoolToken)
+	if err != nil {
+		return err
+	}
+
+	if err := securityhelper.SetPrincipalPermissions(d, sn, &securityhelper.PermissionTypeValues.NotSet, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func createAgentPoolToken(d *schema.ResourceData, clients *client.AggregatedClient) (string, error) {
+	// Project scope
+	// Token format for ALL agent queues in a project: AgentQueues/ProjectID
+	// Token format for a specific agent queue in a project: AgentQueues/ProjectID/QueueID
+	if projectID, ok := d.GetOk("project_id"); ok {
+		aclToken := "AgentQueues/" + projectID.(string)
+		if queueID, ok := d.GetOk("agent_queue_id"); ok {
+			aclToken += "/" + strconv.Itoa(queueID.(int))
+		}
+		return aclToken, nil
+	}
+	if _, ok := d.GetOk("agent_queue_id"); ok {
+		return "", fmt.Errorf("'project_id' is required if 'agent_queue_id' is specified")
+	}
+
+	// Collection scope
+	// Token format for ALL agent pools of the organization: AgentPools
+	// Token format for a specific agent pool: AgentPools/PoolID
+	aclToken := "AgentPools"
+	if poolID, ok := d.GetOk("agent_pool_id"); ok {
+		aclToken += "/" + strconv.Itoa(poolID.(int))
+	}
+	return aclToken, nil
+}
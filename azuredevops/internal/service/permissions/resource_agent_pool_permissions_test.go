@@ -0,0 +1,62 @@
+//go:build (all || permissions || resource_agent_pool_permissions) && (!exclude_permissions || !resource_agent_pool_permissions)
+// +build all permissions resource_agent_pool_permissions
+// +build !exclude_permissions !resource_agent_pool_permissions
+
+package permissions
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+ * Begin unit tests
+ */
+
+func TestAgentPoolPermissions_CreateAgentPoolToken_CollectionScope(t *testing.T) {
+	d := getAgentPoolPermissionsResource(t, 0, "", 0)
+	token, err := createAgentPoolToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "AgentPools", token)
+
+	d = getAgentPoolPermissionsResource(t, 12, "", 0)
+	token, err = createAgentPoolToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "AgentPools/12", token)
+}
+
+func TestAgentPoolPermissions_CreateAgentPoolToken_ProjectScope(t *testing.T) {
+	d := getAgentPoolPermissionsResource(t, 0, projectID, 0)
+	token, err := createAgentPoolToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("AgentQueues/%s", projectID), token)
+
+	d = getAgentPoolPermissionsResource(t, 0, projectID, 7)
+	token, err = createAgentPoolToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("AgentQueues/%s/7", projectID), token)
+}
+
+func TestAgentPoolPermissions_CreateAgentPoolToken_QueueWithoutProject(t *testing.T) {
+	d := getAgentPoolPermissionsResource(t, 0, "", 7)
+	token, err := createAgentPoolToken(d, nil)
+	assert.Empty(t, token)
+	assert.NotNil(t, err)
+}
+
+func getAgentPoolPermissionsResource(t *testing.T, agentPoolID int, projectID string, agentQueueID int) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceAgentPoolPermissions().Schema, nil)
+	if agentPoolID != 0 {
+		d.Set("agent_pool_id", agentPoolID)
+	}
+	if projectID != "" {
+		d.Set("project_id", projectID)
+	}
+	if agentQueueID != 0 {
+		d.Set("agent_queue_id", agentQueueID)
+	}
+	return d
+}
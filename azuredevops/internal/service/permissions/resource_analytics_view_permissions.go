@@ -0,0 +1,109 @@
+package permissions
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+)
+
+// ResourceAnalyticsViewPermissions schema and implementation for analytics view permission resource
+func ResourceAnalyticsViewPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAnalyticsViewPermissionsCreateOrUpdate,
+		Read:   resourceAnalyticsViewPermissionsRead,
+		Update: resourceAnalyticsViewPermissionsCreateOrUpdate,
+		Delete: resourceAnalyticsViewPermissionsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		CustomizeDiff: securityhelper.ValidatePermissionNames(securityhelper.SecurityNamespaceIDValues.AnalyticsViews),
+		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"analytics_view_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				ForceNew:     true,
+				Optional:     true,
+			},
+		}),
+	}
+}
+
+func resourceAnalyticsViewPermissionsCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.AnalyticsViews, createAnalyticsViewToken)
+	if err != nil {
+		return err
+	}
+
+	if err := securityhelper.SetPrincipalPermissions(d, sn, nil, false); err != nil {
+		return err
+	}
+
+	return resourceAnalyticsViewPermissionsRead(d, m)
+}
+
+func resourceAnalyticsViewPermissionsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.AnalyticsViews, createAnalyticsViewToken)
+	if err != nil {
+		return err
+	}
+
+	principalPermissions, err := securityhelper.GetPrincipalPermissions(d, sn)
+	if err != nil {
+		return err
+	}
+	if principalPermissions == nil {
+		d.SetId("")
+		log.Printf("[INFO] Permissions for ACL token %q not found. Removing from state", sn.GetToken())
+		return nil
+	}
+
+	d.Set("permissions", principalPermissions.Permissions)
+	return nil
+}
+
+func resourceAnalyticsViewPermissionsDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.AnalyticsViews, createAnalyticsViewToken)
+	if err != nil {
+		return err
+	}
+
+	if err := securityhelper.SetPrincipalPermissions(d, sn, &securityhelper.PermissionTypeValues.NotSet, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func createAnalyticsViewToken(d *schema.ResourceData, clients *client.AggregatedClient) (string, error) {
+	projectID, ok := d.GetOk("project_id")
+	if !ok {
+		return "", fmt.Errorf("Failed to get 'project_id' from schema")
+	}
+	// Token format for ALL shared analytics views of a project: $/Shared/ProjectID
+	// Token format for a specific shared analytics view: $/Shared/ProjectID/ViewID
+	aclToken := "$/Shared/" + projectID.(string)
+	if viewID, ok := d.GetOk("analytics_view_id"); ok {
+		aclToken += "/" + viewID.(string)
+	}
+	return aclToken, nil
+}
@@ -0,0 +1,47 @@
+//go:build (all || permissions || resource_analytics_view_permissions) && (!exclude_permissions || !resource_analytics_view_permissions)
+// +build all permissions resource_analytics_view_permissions
+// +build !exclude_permissions !resource_analytics_view_permissions
+
+package permissions
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+ * Begin unit tests
+ */
+
+var analyticsViewID = "0f3c2e51-6a7b-4c8d-9e0f-1a2b3c4d5e6f"
+
+func TestAnalyticsViewPermissions_CreateAnalyticsViewToken(t *testing.T) {
+	d := getAnalyticsViewPermissionsResource(t, projectID, "")
+	token, err := createAnalyticsViewToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("$/Shared/%s", projectID), token)
+
+	d = getAnalyticsViewPermissionsResource(t, projectID, analyticsViewID)
+	token, err = createAnalyticsViewToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("$/Shared/%s/%s", projectID, analyticsViewID), token)
+
+	d = getAnalyticsViewPermissionsResource(t, "", analyticsViewID)
+	token, err = createAnalyticsViewToken(d, nil)
+	assert.Empty(t, token)
+	assert.NotNil(t, err)
+}
+
+func getAnalyticsViewPermissionsResource(t *testing.T, projectID string, viewID string) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceAnalyticsViewPermissions().Schema, nil)
+	if projectID != "" {
+		d.Set("project_id", projectID)
+	}
+	if viewID != "" {
+		d.Set("analytics_view_id", viewID)
+	}
+	return d
+}
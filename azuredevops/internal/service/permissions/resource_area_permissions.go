@@ -24,6 +24,7 @@ func ResourceAreaPermissions() *schema.Resource {
 			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
+		CustomizeDiff: securityhelper.ValidatePermissionNames(securityhelper.SecurityNamespaceIDValues.CSS),
 		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
@@ -0,0 +1,85 @@
+package permissions
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+)
+
+// ResourceAuditLogPermissions schema and implementation for audit log permission resource
+func ResourceAuditLogPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuditLogPermissionsCreateOrUpdate,
+		Read:   resourceAuditLogPermissionsRead,
+		Update: resourceAuditLogPermissionsCreateOrUpdate,
+		Delete: resourceAuditLogPermissionsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		CustomizeDiff: securityhelper.ValidatePermissionNames(securityhelper.SecurityNamespaceIDValues.AuditLog),
+		Schema:        securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{}),
+	}
+}
+
+func resourceAuditLogPermissionsCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.AuditLog, createAuditLogToken)
+	if err != nil {
+		return err
+	}
+
+	if err := securityhelper.SetPrincipalPermissions(d, sn, nil, false); err != nil {
+		return err
+	}
+
+	return resourceAuditLogPermissionsRead(d, m)
+}
+
+func resourceAuditLogPermissionsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.AuditLog, createAuditLogToken)
+	if err != nil {
+		return err
+	}
+
+	principalPermissions, err := securityhelper.GetPrincipalPermissions(d, sn)
+	if err != nil {
+		return err
+	}
+	if principalPermissions == nil {
+		d.SetId("")
+		log.Printf("[INFO] Permissions for ACL token %q not found. Removing from state", sn.GetToken())
+		return nil
+	}
+
+	d.Set("permissions", principalPermissions.Permissions)
+	return nil
+}
+
+func resourceAuditLogPermissionsDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.AuditLog, createAuditLogToken)
+	if err != nil {
+		return err
+	}
+
+	if err := securityhelper.SetPrincipalPermissions(d, sn, &securityhelper.PermissionTypeValues.NotSet, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func createAuditLogToken(d *schema.ResourceData, clients *client.AggregatedClient) (string, error) {
+	// The audit log of the organization is secured by a single token
+	return "AllPermissions", nil
+}
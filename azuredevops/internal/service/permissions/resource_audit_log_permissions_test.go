@@ -0,0 +1,23 @@
+//go:build (all || permissions || resource_audit_log_permissions) && (!exclude_permissions || !resource_audit_log_permissions)
+// +build all permissions resource_audit_log_permissions
+// +build !exclude_permissions !resource_audit_log_permissions
+
+package permissions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+ * Begin unit tests
+ */
+
+func TestAuditLogPermissions_CreateAuditLogToken(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceAuditLogPermissions().Schema, nil)
+	token, err := createAuditLogToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "AllPermissions", token)
+}
@@ -29,6 +29,7 @@ func ResourceBuildDefinitionPermissions() *schema.Resource {
 			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
+		CustomizeDiff: securityhelper.ValidatePermissionNames(securityhelper.SecurityNamespaceIDValues.Build),
 		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
@@ -0,0 +1,121 @@
+package permissions
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+)
+
+// ResourceDashboardPermissions schema and implementation for dashboard permission resource
+func ResourceDashboardPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDashboardPermissionsCreateOrUpdate,
+		Read:   resourceDashboardPermissionsRead,
+		Update: resourceDashboardPermissionsCreateOrUpdate,
+		Delete: resourceDashboardPermissionsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		CustomizeDiff: securityhelper.ValidatePermissionNames(securityhelper.SecurityNamespaceIDValues.DashboardsPrivileges),
+		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"team_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				ForceNew:     true,
+				Optional:     true,
+			},
+			"dashboard_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				ForceNew:     true,
+				Optional:     true,
+			},
+		}),
+	}
+}
+
+func resourceDashboardPermissionsCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.DashboardsPrivileges, createDashboardToken)
+	if err != nil {
+		return err
+	}
+
+	if err := securityhelper.SetPrincipalPermissions(d, sn, nil, false); err != nil {
+		return err
+	}
+
+	return resourceDashboardPermissionsRead(d, m)
+}
+
+func resourceDashboardPermissionsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.DashboardsPrivileges, createDashboardToken)
+	if err != nil {
+		return err
+	}
+
+	principalPermissions, err := securityhelper.GetPrincipalPermissions(d, sn)
+	if err != nil {
+		return err
+	}
+	if principalPermissions == nil {
+		d.SetId("")
+		log.Printf("[INFO] Permissions for ACL token %q not found. Removing from state", sn.GetToken())
+		return nil
+	}
+
+	d.Set("permissions", principalPermissions.Permissions)
+	return nil
+}
+
+func resourceDashboardPermissionsDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.DashboardsPrivileges, createDashboardToken)
+	if err != nil {
+		return err
+	}
+
+	if err := securityhelper.SetPrincipalPermissions(d, sn, &securityhelper.PermissionTypeValues.NotSet, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func createDashboardToken(d *schema.ResourceData, clients *client.AggregatedClient) (string, error) {
+	projectID, ok := d.GetOk("project_id")
+	if !ok {
+		return "", fmt.Errorf("Failed to get 'project_id' from schema")
+	}
+	// Project dashboards are not owned by a team and use an empty team ID
+	// Token format for ALL dashboards of a project or team: $/ProjectID/TeamID
+	// Token format for a specific dashboard: $/ProjectID/TeamID/DashboardID
+	teamID := uuid.Nil.String()
+	if v, ok := d.GetOk("team_id"); ok {
+		teamID = v.(string)
+	}
+	aclToken := fmt.Sprintf("$/%s/%s", projectID.(string), teamID)
+	if dashboardID, ok := d.GetOk("dashboard_id"); ok {
+		aclToken += "/" + dashboardID.(string)
+	}
+	return aclToken, nil
+}
@@ -0,0 +1,67 @@
+//go:build (all || permissions || resource_dashboard_permissions) && (!exclude_permissions || !resource_dashboard_permissions)
+// +build all permissions resource_dashboard_permissions
+// +build !exclude_permissions !resource_dashboard_permissions
+
+package permissions
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+ * Begin unit tests
+ */
+
+var (
+	dashboardTeamID = "2c0d6e8f-4f4a-4bd4-9a4e-0c1c2a7f7d3b"
+	dashboardID     = "7a5b8c4e-2d1f-4a9b-8e7c-6f5d4c3b2a19"
+)
+
+func TestDashboardPermissions_CreateDashboardToken_ProjectDashboards(t *testing.T) {
+	d := getDashboardPermissionsResource(t, projectID, "", "")
+	token, err := createDashboardToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("$/%s/00000000-0000-0000-0000-000000000000", projectID), token)
+
+	d = getDashboardPermissionsResource(t, projectID, "", dashboardID)
+	token, err = createDashboardToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("$/%s/00000000-0000-0000-0000-000000000000/%s", projectID, dashboardID), token)
+}
+
+func TestDashboardPermissions_CreateDashboardToken_TeamDashboards(t *testing.T) {
+	d := getDashboardPermissionsResource(t, projectID, dashboardTeamID, "")
+	token, err := createDashboardToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("$/%s/%s", projectID, dashboardTeamID), token)
+
+	d = getDashboardPermissionsResource(t, projectID, dashboardTeamID, dashboardID)
+	token, err = createDashboardToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("$/%s/%s/%s", projectID, dashboardTeamID, dashboardID), token)
+}
+
+func TestDashboardPermissions_CreateDashboardToken_MissingProject(t *testing.T) {
+	d := getDashboardPermissionsResource(t, "", dashboardTeamID, dashboardID)
+	token, err := createDashboardToken(d, nil)
+	assert.Empty(t, token)
+	assert.NotNil(t, err)
+}
+
+func getDashboardPermissionsResource(t *testing.T, projectID string, teamID string, dashboardID string) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceDashboardPermissions().Schema, nil)
+	if projectID != "" {
+		d.Set("project_id", projectID)
+	}
+	if teamID != "" {
+		d.Set("team_id", teamID)
+	}
+	if dashboardID != "" {
+		d.Set("dashboard_id", dashboardID)
+	}
+	return d
+}
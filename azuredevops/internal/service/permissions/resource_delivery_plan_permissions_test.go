@@ -0,0 +1,47 @@
+//go:build (all || permissions || resource_delivery_plan_permissions) && (!exclude_permissions || !resource_delivery_plan_permissions)
+// +build all permissions resource_delivery_plan_permissions
+// +build !exclude_permissions !resource_delivery_plan_permissions
+
+package permissions
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+ * Begin unit tests
+ */
+
+var deliveryPlanID = "5e4d3c2b-1a09-4f8e-b7d6-c5b4a3928170"
+
+func TestDeliveryPlanPermissions_CreateDeliveryPlanToken(t *testing.T) {
+	d := getDeliveryPlanPermissionsResource(t, projectID, "")
+	token, err := createDeliveryPlanToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("Plan/%s", projectID), token)
+
+	d = getDeliveryPlanPermissionsResource(t, projectID, deliveryPlanID)
+	token, err = createDeliveryPlanToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("Plan/%s/%s", projectID, deliveryPlanID), token)
+
+	d = getDeliveryPlanPermissionsResource(t, "", deliveryPlanID)
+	token, err = createDeliveryPlanToken(d, nil)
+	assert.Empty(t, token)
+	assert.NotNil(t, err)
+}
+
+func getDeliveryPlanPermissionsResource(t *testing.T, projectID string, planID string) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceDeliveryPlanPermissions().Schema, nil)
+	if projectID != "" {
+		d.Set("project_id", projectID)
+	}
+	if planID != "" {
+		d.Set("plan_id", planID)
+	}
+	return d
+}
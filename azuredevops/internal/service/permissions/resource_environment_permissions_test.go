@@ -0,0 +1,55 @@
+//go:build (all || permissions || resource_environment_permissions) && (!exclude_permissions || !resource_environment_permissions)
+// +build all permissions resource_environment_permissions
+// +build !exclude_permissions !resource_environment_permissions
+
+package permissions
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+ * Begin unit tests
+ */
+
+var (
+	environmentID               = "3"
+	environmentProjectToken     = fmt.Sprintf("Environments/%s", projectID)
+	environmentEnvironmentToken = fmt.Sprintf("Environments/%s/%s", projectID, environmentID)
+)
+
+func TestEnvironmentPermissions_CreateEnvironmentToken(t *testing.T) {
+	var d *schema.ResourceData
+	var token string
+	var err error
+
+	d = getEnvironmentPermissionsResource(t, projectID, "")
+	token, err = createEnvironmentToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, environmentProjectToken, token)
+
+	d = getEnvironmentPermissionsResource(t, projectID, environmentID)
+	token, err = createEnvironmentToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, environmentEnvironmentToken, token)
+
+	d = getEnvironmentPermissionsResource(t, "", environmentID)
+	token, err = createEnvironmentToken(d, nil)
+	assert.Empty(t, token)
+	assert.NotNil(t, err)
+}
+
+func getEnvironmentPermissionsResource(t *testing.T, projectID string, environmentID string) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceEnvironmentPermissions().Schema, nil)
+	if projectID != "" {
+		d.Set("project_id", projectID)
+	}
+	if environmentID != "" {
+		d.Set("environment_id", environmentID)
+	}
+	return d
+}
@@ -0,0 +1,138 @@
+package permissions
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceGitPermissionsAcl schema and implementation for the authoritative Git repository ACL resource
+func ResourceGitPermissionsAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitPermissionsAclCreateOrUpdate,
+		Read:   resourceGitPermissionsAclRead,
+		Update: resourceGitPermissionsAclCreateOrUpdate,
+		Delete: resourceGitPermissionsAclDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			State: importGitPermissionsAcl,
+		},
+		CustomizeDiff: securityhelper.ValidateAclPermissionNames(securityhelper.SecurityNamespaceIDValues.GitRepositories),
+		Schema: securityhelper.CreateAclResourceSchema(map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"repository_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				Optional:     true,
+				ForceNew:     true,
+			},
+			"branch_name": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"repository_id"},
+			},
+		}),
+	}
+}
+
+func resourceGitPermissionsAclCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.GitRepositories, createGitToken)
+	if err != nil {
+		return err
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	if err := securityhelper.SetAclPermissions(d, sn, timeout); err != nil {
+		return err
+	}
+
+	return resourceGitPermissionsAclRead(d, m)
+}
+
+func resourceGitPermissionsAclRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.GitRepositories, createGitToken)
+	if err != nil {
+		return err
+	}
+
+	permissions, err := securityhelper.GetAclPermissions(d, sn)
+	if err != nil {
+		return err
+	}
+
+	d.Set("permission", permissions)
+	return nil
+}
+
+func resourceGitPermissionsAclDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.GitRepositories, createGitToken)
+	if err != nil {
+		return err
+	}
+
+	if err := securityhelper.RemoveAclPermissions(d, sn); err != nil {
+		return err
+	}
+	return nil
+}
+
+// importGitPermissionsAcl imports the ACL given its token, e.g. repoV2/<projectID>/<repositoryID>/refs/heads/<encodedBranchName>
+func importGitPermissionsAcl(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	token := d.Id()
+	parts := strings.Split(token, "/")
+	if len(parts) < 2 || parts[0] != "repoV2" || len(parts) == 4 || len(parts) == 5 || (len(parts) > 5 && (parts[3] != "refs" || parts[4] != "heads")) {
+		return nil, fmt.Errorf("Unexpected format of ID (%s), expected repoV2/<projectID>[/<repositoryID>[/refs/heads/<encodedBranchName>]]", token)
+	}
+	if _, err := uuid.Parse(parts[1]); err != nil {
+		return nil, fmt.Errorf("Unexpected format of ID (%s), %s is not a UUID", token, parts[1])
+	}
+	d.Set("project_id", parts[1])
+
+	if len(parts) > 2 {
+		if _, err := uuid.Parse(parts[2]); err != nil {
+			return nil, fmt.Errorf("Unexpected format of ID (%s), %s is not a UUID", token, parts[2])
+		}
+		d.Set("repository_id", parts[2])
+	}
+
+	if len(parts) > 5 {
+		branchPaths := make([]string, 0, len(parts)-5)
+		for _, encoded := range parts[5:] {
+			decoded, err := converter.DecodeUtf16HexString(encoded)
+			if err != nil {
+				return nil, fmt.Errorf("Decoding the branch name of ID (%s): %+v", token, err)
+			}
+			branchPaths = append(branchPaths, decoded)
+		}
+		d.Set("branch_name", strings.Join(branchPaths, "/"))
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,70 @@
+//go:build (all || permissions || resource_git_permissions_acl) && (!exclude_permissions || !exclude_resource_git_permissions_acl)
+// +build all permissions resource_git_permissions_acl
+// +build !exclude_permissions !exclude_resource_git_permissions_acl
+
+package permissions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	gitAclProjectID    = "9083e944-8e9e-405e-960a-c80180aa71e6"
+	gitAclRepositoryID = "c629a0a4-926d-45d1-8095-6e2499cf3938"
+)
+
+func TestGitPermissionsAcl_Import_BranchToken(t *testing.T) {
+	encodedFeature, err := converter.EncodeUtf16HexString("feature")
+	require.NoError(t, err)
+	encodedName, err := converter.EncodeUtf16HexString("example")
+	require.NoError(t, err)
+	token := "repoV2/" + gitAclProjectID + "/" + gitAclRepositoryID + "/refs/heads/" + encodedFeature + "/" + encodedName
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPermissionsAcl().Schema, nil)
+	d.SetId(token)
+	imported, err := importGitPermissionsAcl(d, nil)
+	require.NoError(t, err)
+	require.Len(t, imported, 1)
+
+	assert.Equal(t, gitAclProjectID, d.Get("project_id"))
+	assert.Equal(t, gitAclRepositoryID, d.Get("repository_id"))
+	assert.Equal(t, "feature/example", d.Get("branch_name"))
+
+	// the imported attributes yield the token again
+	recreated, err := createGitToken(d, &client.AggregatedClient{})
+	require.NoError(t, err)
+	assert.Equal(t, token, recreated)
+}
+
+func TestGitPermissionsAcl_Import_ProjectToken(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceGitPermissionsAcl().Schema, nil)
+	d.SetId("repoV2/" + gitAclProjectID)
+	_, err := importGitPermissionsAcl(d, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, gitAclProjectID, d.Get("project_id"))
+	assert.Empty(t, d.Get("repository_id"))
+	assert.Empty(t, d.Get("branch_name"))
+}
+
+func TestGitPermissionsAcl_Import_RejectsInvalidToken(t *testing.T) {
+	for _, token := range []string{
+		"repoV2",
+		"repo/" + gitAclProjectID,
+		"repoV2/not-a-uuid",
+		"repoV2/" + gitAclProjectID + "/" + gitAclRepositoryID + "/refs",
+		"repoV2/" + gitAclProjectID + "/" + gitAclRepositoryID + "/refs/heads",
+		"repoV2/" + gitAclProjectID + "/" + gitAclRepositoryID + "/refs/tags/0076",
+	} {
+		d := schema.TestResourceDataRaw(t, ResourceGitPermissionsAcl().Schema, nil)
+		d.SetId(token)
+		_, err := importGitPermissionsAcl(d, nil)
+		assert.Error(t, err, token)
+	}
+}
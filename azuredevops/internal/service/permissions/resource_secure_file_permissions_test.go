@@ -0,0 +1,54 @@
+//go:build (all || permissions || resource_secure_file_permissions) && (!exclude_permissions || !resource_secure_file_permissions)
+// +build all permissions resource_secure_file_permissions
+// +build !exclude_permissions !resource_secure_file_permissions
+
+package permissions
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+ * Begin unit tests
+ */
+
+var (
+	secureFileID    = "1a0e83e1-7e4b-4f6d-8f4a-6c4f1f0d2b9e"
+	secureFileToken = fmt.Sprintf("Library/%s/SecureFile/%s", projectID, secureFileID)
+)
+
+func TestSecureFilePermissions_CreateSecureFileToken(t *testing.T) {
+	var d *schema.ResourceData
+	var token string
+	var err error
+
+	d = getSecureFilePermissionsResource(t, projectID, secureFileID)
+	token, err = createSecureFileToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, secureFileToken, token)
+
+	d = getSecureFilePermissionsResource(t, projectID, "")
+	token, err = createSecureFileToken(d, nil)
+	assert.Empty(t, token)
+	assert.NotNil(t, err)
+
+	d = getSecureFilePermissionsResource(t, "", secureFileID)
+	token, err = createSecureFileToken(d, nil)
+	assert.Empty(t, token)
+	assert.NotNil(t, err)
+}
+
+func getSecureFilePermissionsResource(t *testing.T, projectID string, secureFileID string) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceSecureFilePermissions().Schema, nil)
+	if projectID != "" {
+		d.Set("project_id", projectID)
+	}
+	if secureFileID != "" {
+		d.Set("secure_file_id", secureFileID)
+	}
+	return d
+}
@@ -0,0 +1,122 @@
+package permissions
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceTfvcPermissions schema and implementation for TFVC permission resource
+func ResourceTfvcPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTfvcPermissionsCreateOrUpdate,
+		Read:   resourceTfvcPermissionsRead,
+		Update: resourceTfvcPermissionsCreateOrUpdate,
+		Delete: resourceTfvcPermissionsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		CustomizeDiff: securityhelper.ValidatePermissionNames(securityhelper.SecurityNamespaceIDValues.VersionControlItems),
+		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"path": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Optional:     true,
+				ForceNew:     true,
+			},
+		}),
+	}
+}
+
+func resourceTfvcPermissionsCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.VersionControlItems, createTfvcToken)
+	if err != nil {
+		return err
+	}
+
+	if err := securityhelper.SetPrincipalPermissions(d, sn, nil, false); err != nil {
+		return err
+	}
+
+	return resourceTfvcPermissionsRead(d, m)
+}
+
+func resourceTfvcPermissionsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.VersionControlItems, createTfvcToken)
+	if err != nil {
+		return err
+	}
+
+	principalPermissions, err := securityhelper.GetPrincipalPermissions(d, sn)
+	if err != nil {
+		return err
+	}
+	if principalPermissions == nil {
+		d.SetId("")
+		log.Printf("[INFO] Permissions for ACL token %q not found. Removing from state", sn.GetToken())
+		return nil
+	}
+
+	d.Set("permissions", principalPermissions.Permissions)
+	return nil
+}
+
+func resourceTfvcPermissionsDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.VersionControlItems, createTfvcToken)
+	if err != nil {
+		return err
+	}
+
+	if err := securityhelper.SetPrincipalPermissions(d, sn, &securityhelper.PermissionTypeValues.NotSet, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// createTfvcToken builds the VersionControlItems token, which is the server path of the item, e.g. $/<project name>/Main
+func createTfvcToken(d *schema.ResourceData, clients *client.AggregatedClient) (string, error) {
+	projectID, ok := d.GetOk("project_id")
+	if !ok {
+		return "", fmt.Errorf("Failed to get 'project_id' from schema")
+	}
+
+	project, err := clients.CoreClient.GetProject(clients.Ctx, core.GetProjectArgs{
+		ProjectId: converter.String(projectID.(string)),
+	})
+	if err != nil {
+		return "", fmt.Errorf("Failed to read project %s: %+v", projectID, err)
+	}
+
+	aclToken := "$/" + *project.Name
+	if path, ok := d.GetOk("path"); ok {
+		path := strings.TrimPrefix(path.(string), aclToken)
+		path = strings.Trim(strings.ReplaceAll(path, "\\", "/"), "/")
+		if path != "" {
+			aclToken = aclToken + "/" + path
+		}
+	}
+	return aclToken, nil
+}
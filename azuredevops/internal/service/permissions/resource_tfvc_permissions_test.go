@@ -0,0 +1,97 @@
+//go:build (all || permissions || resource_tfvc_permissions) && (!exclude_permissions || !resource_tfvc_permissions)
+// +build all permissions resource_tfvc_permissions
+// +build !exclude_permissions !resource_tfvc_permissions
+
+package permissions
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+/**
+ * Begin unit tests
+ */
+
+var tfvcProjectID = "9083e944-8e9e-405e-960a-c80180aa71e6"
+
+func TestTfvcPermissions_CreateTfvcToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	coreClient.EXPECT().
+		GetProject(clients.Ctx, core.GetProjectArgs{ProjectId: converter.String(tfvcProjectID)}).
+		Return(&core.TeamProject{Name: converter.String("Contoso")}, nil).
+		Times(4)
+
+	tests := []struct {
+		path  string
+		token string
+	}{
+		{"", "$/Contoso"},
+		{"Main/Dev", "$/Contoso/Main/Dev"},
+		{"$/Contoso/Main/", "$/Contoso/Main"},
+		{"\\Main\\Dev", "$/Contoso/Main/Dev"},
+	}
+	for _, test := range tests {
+		d := getTfvcPermissionsResource(t, tfvcProjectID, test.path)
+		token, err := createTfvcToken(d, clients)
+		assert.Nil(t, err)
+		assert.Equal(t, test.token, token)
+	}
+}
+
+func TestTfvcPermissions_CreateTfvcToken_HandlesErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	d := getTfvcPermissionsResource(t, "", "")
+	token, err := createTfvcToken(d, clients)
+	assert.Empty(t, token)
+	assert.NotNil(t, err)
+
+	coreClient.EXPECT().
+		GetProject(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("@@GetProject@@failed")).
+		Times(1)
+
+	d = getTfvcPermissionsResource(t, tfvcProjectID, "")
+	token, err = createTfvcToken(d, clients)
+	assert.Empty(t, token)
+	assert.ErrorContains(t, err, "@@GetProject@@failed")
+}
+
+func getTfvcPermissionsResource(t *testing.T, projectID string, path string) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceTfvcPermissions().Schema, nil)
+	if projectID != "" {
+		d.Set("project_id", projectID)
+	}
+	if path != "" {
+		d.Set("path", path)
+	}
+	return d
+}
@@ -25,6 +25,7 @@ func ResourceWorkItemTrackingProcessPermissions() *schema.Resource {
 			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
+		CustomizeDiff: securityhelper.ValidatePermissionNames(securityhelper.SecurityNamespaceIDValues.Process),
 		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
 			"process_id": {
 				Type:         schema.TypeString,
@@ -0,0 +1,327 @@
+package utils
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// CreateAclResourceSchema creates a resources schema for a Terraform resource managing the whole ACL of a token
+func CreateAclResourceSchema(outer map[string]*schema.Schema) map[string]*schema.Schema {
+	baseSchema := map[string]*schema.Schema{
+		"permission": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"principal": {
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+						Required:     true,
+					},
+					"permissions": {
+						Type:     schema.TypeMap,
+						Required: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+		"inherit": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"ignore_principals": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+	}
+
+	for key, elem := range baseSchema {
+		outer[key] = elem
+	}
+
+	return outer
+}
+
+// SetAclPermissions sets the permissions of all declared principals and removes the ACEs of all principals,
+// which are neither declared nor ignored, from the ACL of the token. It waits up to timeout for the permissions
+// to be applied.
+func SetAclPermissions(d *schema.ResourceData, sn *SecurityNamespace, timeout time.Duration) error {
+	declared := expandAclPermissions(d.Get("permission").(*schema.Set))
+
+	setPermissions := make([]SetPrincipalPermission, 0, len(declared))
+	for _, principalPermission := range declared {
+		setPermissions = append(setPermissions, SetPrincipalPermission{
+			Replace:             true,
+			PrincipalPermission: principalPermission,
+		})
+	}
+	if err := sn.SetPrincipalPermissions(&setPermissions); err != nil {
+		return err
+	}
+
+	subjects, err := sn.GetAccessControlListSubjects()
+	if err != nil {
+		return err
+	}
+	undeclared := undeclaredAclSubjects(subjects, declared, expandIgnoredPrincipals(d))
+	if len(undeclared) > 0 {
+		log.Printf("[DEBUG] Removing undeclared principals %s from the ACL of token %q", strings.Join(undeclared, ", "), sn.token)
+		if err := sn.RemovePrincipalPermissions(&undeclared); err != nil {
+			return fmt.Errorf("Removing undeclared principals from the ACL of token %s: %+v", sn.token, err)
+		}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"Waiting"},
+		Target:  []string{"Synched"},
+		Refresh: func() (interface{}, string, error) {
+			principals := make([]string, 0, len(declared))
+			for _, principalPermission := range declared {
+				principals = append(principals, principalPermission.SubjectDescriptor)
+			}
+			currentPermissions, err := sn.GetPrincipalPermissions(&principals)
+			if err != nil {
+				return nil, "", fmt.Errorf("Reading permissions for principals %s: %+v", strings.Join(principals, ", "), err)
+			}
+
+			if currentPermissions == nil {
+				currentPermissions = &[]PrincipalPermission{}
+			}
+			state := "Synched"
+			if !aclPermissionsInSync(declared, *currentPermissions) {
+				state = "Waiting"
+			}
+			return state, state, nil
+		},
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		Delay:                     5 * time.Second,
+		ContinuousTargetOccurence: 1,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil { //nolint:staticcheck
+		return fmt.Errorf("waiting for permission update. %v ", err)
+	}
+
+	if err := SetInheritPermissions(d, sn); err != nil {
+		return err
+	}
+
+	d.SetId(sn.token)
+	return nil
+}
+
+// GetAclPermissions returns the permissions of all principals in the ACL of the token, except for the ignored principals
+func GetAclPermissions(d *schema.ResourceData, sn *SecurityNamespace) ([]interface{}, error) {
+	subjects, err := sn.GetAccessControlListSubjects()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := readInheritPermissions(d, sn); err != nil {
+		return nil, err
+	}
+
+	ignored := expandIgnoredPrincipals(d)
+	principals := make([]string, 0, len(subjects))
+	for _, subject := range subjects {
+		if !containsPrincipal(ignored, subject) {
+			principals = append(principals, subject)
+		}
+	}
+	if len(principals) == 0 {
+		return []interface{}{}, nil
+	}
+
+	principalPermissions, err := sn.GetPrincipalPermissions(&principals)
+	if err != nil {
+		return nil, err
+	}
+	if principalPermissions == nil {
+		return []interface{}{}, nil
+	}
+	return flattenAclPermissions(expandAclPermissions(d.Get("permission").(*schema.Set)), *principalPermissions), nil
+}
+
+// RemoveAclPermissions removes the ACEs of all declared principals from the ACL of the token and restores its inheritance
+func RemoveAclPermissions(d *schema.ResourceData, sn *SecurityNamespace) error {
+	declared := expandAclPermissions(d.Get("permission").(*schema.Set))
+	if len(declared) > 0 {
+		principals := make([]string, 0, len(declared))
+		for _, principalPermission := range declared {
+			principals = append(principals, principalPermission.SubjectDescriptor)
+		}
+		if err := sn.RemovePrincipalPermissions(&principals); err != nil {
+			return err
+		}
+	}
+	return RestoreInheritPermissions(d, sn)
+}
+
+func expandAclPermissions(set *schema.Set) []PrincipalPermission {
+	if set == nil {
+		return []PrincipalPermission{}
+	}
+
+	result := make([]PrincipalPermission, 0, set.Len())
+	for _, raw := range set.List() {
+		item := raw.(map[string]interface{})
+		permissions := map[ActionName]PermissionType{}
+		for key, value := range item["permissions"].(map[string]interface{}) {
+			permissions[ActionName(key)] = PermissionType(value.(string))
+		}
+		result = append(result, PrincipalPermission{
+			SubjectDescriptor: item["principal"].(string),
+			Permissions:       permissions,
+		})
+	}
+	return result
+}
+
+// flattenAclPermissions converts the current permissions to the schema representation. Only the declared
+// actions of declared principals are reported, preserving the configured casing. Undeclared principals are
+// reported with all their explicitly allowed or denied actions, so that they show up as a difference.
+func flattenAclPermissions(declared []PrincipalPermission, current []PrincipalPermission) []interface{} {
+	result := make([]interface{}, 0, len(current))
+
+	// the service drops ACEs without any allowed or denied action, those principals are in the desired state
+	for _, declaredPermission := range declared {
+		if findPrincipalPermission(current, declaredPermission.SubjectDescriptor) != nil || !allPermissionsNotSet(declaredPermission) {
+			continue
+		}
+		permissions := map[string]interface{}{}
+		for action, value := range declaredPermission.Permissions {
+			permissions[string(action)] = string(value)
+		}
+		result = append(result, map[string]interface{}{
+			"principal":   declaredPermission.SubjectDescriptor,
+			"permissions": permissions,
+		})
+	}
+
+	for _, currentPermission := range current {
+		declaredPermission := findPrincipalPermission(declared, currentPermission.SubjectDescriptor)
+
+		permissions := map[string]interface{}{}
+		principal := currentPermission.SubjectDescriptor
+		if declaredPermission != nil {
+			principal = declaredPermission.SubjectDescriptor
+			for action, value := range currentPermission.Permissions {
+				configured, ok := declaredPermission.Permissions[action]
+				if !ok {
+					continue
+				}
+				if strings.EqualFold(string(configured), string(value)) {
+					permissions[string(action)] = string(configured)
+				} else {
+					permissions[string(action)] = string(value)
+				}
+			}
+		} else {
+			for action, value := range currentPermission.Permissions {
+				if value != PermissionTypeValues.NotSet {
+					permissions[string(action)] = string(value)
+				}
+			}
+			if len(permissions) == 0 {
+				continue
+			}
+		}
+
+		result = append(result, map[string]interface{}{
+			"principal":   principal,
+			"permissions": permissions,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].(map[string]interface{})["principal"].(string) < result[j].(map[string]interface{})["principal"].(string)
+	})
+	return result
+}
+
+func undeclaredAclSubjects(subjects []string, declared []PrincipalPermission, ignored []string) []string {
+	declaredPrincipals := make([]string, 0, len(declared))
+	for _, principalPermission := range declared {
+		declaredPrincipals = append(declaredPrincipals, principalPermission.SubjectDescriptor)
+	}
+
+	var undeclared []string
+	for _, subject := range subjects {
+		if !containsPrincipal(declaredPrincipals, subject) && !containsPrincipal(ignored, subject) {
+			undeclared = append(undeclared, subject)
+		}
+	}
+	return undeclared
+}
+
+func aclPermissionsInSync(declared []PrincipalPermission, current []PrincipalPermission) bool {
+	for _, declaredPermission := range declared {
+		currentPermission := findPrincipalPermission(current, declaredPermission.SubjectDescriptor)
+		if currentPermission == nil {
+			if allPermissionsNotSet(declaredPermission) {
+				continue
+			}
+			return false
+		}
+		for action, value := range declaredPermission.Permissions {
+			if currentValue, ok := currentPermission.Permissions[action]; !ok || !strings.EqualFold(string(value), string(currentValue)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func findPrincipalPermission(permissions []PrincipalPermission, principal string) *PrincipalPermission {
+	for i := range permissions {
+		if strings.EqualFold(permissions[i].SubjectDescriptor, principal) {
+			return &permissions[i]
+		}
+	}
+	return nil
+}
+
+func allPermissionsNotSet(principalPermission PrincipalPermission) bool {
+	for _, value := range principalPermission.Permissions {
+		if !strings.EqualFold(string(value), string(PermissionTypeValues.NotSet)) {
+			return false
+		}
+	}
+	return true
+}
+
+func expandIgnoredPrincipals(d *schema.ResourceData) []string {
+	ignored := []string{}
+	if set, ok := d.GetOk("ignore_principals"); ok {
+		for _, principal := range set.(*schema.Set).List() {
+			ignored = append(ignored, principal.(string))
+		}
+	}
+	return ignored
+}
+
+func containsPrincipal(principals []string, principal string) bool {
+	for _, item := range principals {
+		if strings.EqualFold(item, principal) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+//go:build (all || utils || securitynamespaces) && !exclude_securitynamespaces
+// +build all utils securitynamespaces
+// +build !exclude_securitynamespaces
+
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAclHelper_UndeclaredSubjects(t *testing.T) {
+	declared := []PrincipalPermission{
+		{SubjectDescriptor: "vssgp.Readers"},
+		{SubjectDescriptor: "vssgp.Contributors"},
+	}
+	subjects := []string{"vssgp.readers", "vssgp.Contributors", "vssgp.BuildAdmins", "vssgp.ProjectAdmins"}
+
+	undeclared := undeclaredAclSubjects(subjects, declared, []string{"VSSGP.ProjectAdmins"})
+	assert.Equal(t, []string{"vssgp.BuildAdmins"}, undeclared)
+}
+
+func TestAclHelper_FlattenPreservesConfiguredCasingAndReportsUndeclaredPrincipals(t *testing.T) {
+	declared := []PrincipalPermission{
+		{
+			SubjectDescriptor: "vssgp.Readers",
+			Permissions: map[ActionName]PermissionType{
+				"GenericRead":       "Allow",
+				"GenericContribute": "Deny",
+			},
+		},
+		{
+			SubjectDescriptor: "vssgp.Unset",
+			Permissions: map[ActionName]PermissionType{
+				"GenericRead": "NotSet",
+			},
+		},
+	}
+	current := []PrincipalPermission{
+		{
+			SubjectDescriptor: "vssgp.readers",
+			Permissions: map[ActionName]PermissionType{
+				"GenericRead":       PermissionTypeValues.Allow,
+				"GenericContribute": PermissionTypeValues.NotSet,
+				"ForcePush":         PermissionTypeValues.Deny,
+			},
+		},
+		{
+			SubjectDescriptor: "vssgp.OutOfBand",
+			Permissions: map[ActionName]PermissionType{
+				"GenericRead":       PermissionTypeValues.Allow,
+				"GenericContribute": PermissionTypeValues.NotSet,
+			},
+		},
+		{
+			SubjectDescriptor: "vssgp.Empty",
+			Permissions: map[ActionName]PermissionType{
+				"GenericRead": PermissionTypeValues.NotSet,
+			},
+		},
+	}
+
+	result := flattenAclPermissions(declared, current)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"principal":   "vssgp.OutOfBand",
+			"permissions": map[string]interface{}{"GenericRead": "allow"},
+		},
+		map[string]interface{}{
+			"principal":   "vssgp.Readers",
+			"permissions": map[string]interface{}{"GenericRead": "Allow", "GenericContribute": "notset"},
+		},
+		map[string]interface{}{
+			"principal":   "vssgp.Unset",
+			"permissions": map[string]interface{}{"GenericRead": "NotSet"},
+		},
+	}, result)
+}
+
+func TestAclHelper_PermissionsInSync(t *testing.T) {
+	declared := []PrincipalPermission{
+		{SubjectDescriptor: "vssgp.Readers", Permissions: map[ActionName]PermissionType{"GenericRead": "Allow"}},
+		{SubjectDescriptor: "vssgp.Unset", Permissions: map[ActionName]PermissionType{"GenericRead": "notset"}},
+	}
+
+	assert.True(t, aclPermissionsInSync(declared, []PrincipalPermission{
+		{SubjectDescriptor: "vssgp.Readers", Permissions: map[ActionName]PermissionType{"GenericRead": PermissionTypeValues.Allow}},
+	}))
+	assert.False(t, aclPermissionsInSync(declared, []PrincipalPermission{
+		{SubjectDescriptor: "vssgp.Readers", Permissions: map[ActionName]PermissionType{"GenericRead": PermissionTypeValues.NotSet}},
+	}))
+	assert.False(t, aclPermissionsInSync(declared, []PrincipalPermission{}))
+}
+
+func TestAclHelper_ExpandIgnoredPrincipals(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, CreateAclResourceSchema(map[string]*schema.Schema{}), nil)
+	assert.Empty(t, expandIgnoredPrincipals(d))
+
+	d.Set("ignore_principals", []interface{}{"vssgp.ProjectAdmins"})
+	assert.Equal(t, []string{"vssgp.ProjectAdmins"}, expandIgnoredPrincipals(d))
+}
+
+func TestSecurityNamespace_GetAccessControlListSubjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{
+		SecurityClient: securityClient,
+		IdentityClient: identityClient,
+		Ctx:            context.Background(),
+	}
+
+	sn, err := NewSecurityNamespace(nil, clients, SecurityNamespaceIDValues.GitRepositories, func(d *schema.ResourceData, clients *client.AggregatedClient) (string, error) {
+		return "repoV2/@@accTest@@", nil
+	})
+	assert.Nil(t, err)
+
+	securityClient.
+		EXPECT().
+		QueryAccessControlLists(clients.Ctx, gomock.Any()).
+		Return(&[]security.AccessControlList{
+			{
+				Token: converter.String("repoV2/@@accTest@@"),
+				AcesDictionary: &map[string]security.AccessControlEntry{
+					"Microsoft.TeamFoundation.Identity;S-1-9-2": {},
+					"Microsoft.TeamFoundation.Identity;S-1-9-1": {},
+				},
+			},
+		}, nil).
+		Times(1)
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+			Descriptors: converter.String("Microsoft.TeamFoundation.Identity;S-1-9-1,Microsoft.TeamFoundation.Identity;S-1-9-2"),
+		}).
+		Return(&[]identity.Identity{
+			{SubjectDescriptor: converter.String("vssgp.One")},
+			{SubjectDescriptor: converter.String("vssgp.Two")},
+		}, nil).
+		Times(1)
+
+	subjects, err := sn.GetAccessControlListSubjects()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"vssgp.One", "vssgp.Two"}, subjects)
+}
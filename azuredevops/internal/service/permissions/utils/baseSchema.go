@@ -20,13 +20,17 @@ func CreatePermissionResourceSchema(outer map[string]*schema.Schema) map[string]
 			Optional: true,
 			Default:  true, // when set to false (merge mode), a permission of Allow or Deny CANNOT be replaced with NotSet
 		},
+		"inherit": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
 		"permissions": {
-			// Unable to define a validation function, because the
-			// keys and values can only be validated with an initialized
-			// security client as we must load the security namespace
-			// definition and the available permission settings, and a validation
+			// The keys can only be validated with an initialized security client,
+			// as we must load the security namespace definition, and a validation
 			// function in Terraform only receives the parameter name and the
-			// current value as argument
+			// current value as argument. Resources validate the keys with
+			// ValidatePermissionNames as CustomizeDiff instead.
 			Type:     schema.TypeMap,
 			Required: true,
 			Elem: &schema.Schema{
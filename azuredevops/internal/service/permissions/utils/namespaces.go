@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/ahmetb/go-linq"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/security"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
@@ -184,6 +187,33 @@ type SecurityNamespace struct {
 	identityClient identity.Client
 	actions        *map[string]security.ActionDefinition
 	token          string
+	// inheritPermissions is the inheritance flag of the ACL, as returned by the last ACL query
+	inheritPermissions *bool
+}
+
+// accessControlListLocks serializes the writes to the ACL of a token. The inheritance flag of an ACL can only be set by
+// writing the whole ACL, ACEs written in parallel for the same token would otherwise be lost.
+var accessControlListLocks = struct {
+	sync.Mutex
+	locks map[string]*sync.Mutex
+}{
+	locks: map[string]*sync.Mutex{},
+}
+
+// LockAccessControlList locks the ACL of a token in a security namespace and returns the function releasing the lock
+func LockAccessControlList(namespaceID uuid.UUID, token string) func() {
+	key := namespaceID.String() + "/" + token
+
+	accessControlListLocks.Lock()
+	lock, ok := accessControlListLocks.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		accessControlListLocks.locks[key] = lock
+	}
+	accessControlListLocks.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
 }
 
 // TokenCreatorFunc signature for creating namespace tokens
@@ -263,14 +293,102 @@ func (sn *SecurityNamespace) GetAccessControlList(descriptorList *[]string) (*se
 		return nil, err
 	}
 	if acl == nil || len(*acl) == 0 {
+		// tokens without an ACL inherit the permissions of their parents
+		sn.inheritPermissions = converter.Bool(true)
 		return nil, nil
 	}
 	if len(*acl) != 1 {
 		return nil, fmt.Errorf("Failed to load current ACL for token [%s]. Result set contains more than one ACL", sn.token)
 	}
+	sn.inheritPermissions = converter.Bool(converter.ToBool((*acl)[0].InheritPermissions, true))
 	return &(*acl)[0], nil
 }
 
+// GetInheritPermissions returns whether the token inherits permissions from its parent tokens
+func (sn *SecurityNamespace) GetInheritPermissions() (bool, error) {
+	if sn.inheritPermissions == nil {
+		if _, err := sn.GetAccessControlList(nil); err != nil {
+			return false, err
+		}
+	}
+	return *sn.inheritPermissions, nil
+}
+
+// SetInheritPermissions sets whether the token inherits permissions from its parent tokens. The ACEs of the ACL are retained,
+// the ACL is locked so that no ACE written in parallel by another resource is lost.
+func (sn *SecurityNamespace) SetInheritPermissions(inherit bool) error {
+	defer LockAccessControlList(sn.namespaceID, sn.token)()
+
+	acl, err := sn.GetAccessControlList(nil)
+	if err != nil {
+		return err
+	}
+
+	aces := map[string]security.AccessControlEntry{}
+	if acl != nil && acl.AcesDictionary != nil {
+		for descriptor, ace := range *acl.AcesDictionary {
+			aces[descriptor] = security.AccessControlEntry{
+				Descriptor: ace.Descriptor,
+				Allow:      ace.Allow,
+				Deny:       ace.Deny,
+			}
+		}
+	}
+
+	err = sn.securityClient.SetAccessControlLists(sn.context, security.SetAccessControlListsArgs{
+		SecurityNamespaceId: &sn.namespaceID,
+		AccessControlLists: &azuredevops.VssJsonCollectionWrapper{
+			Count: converter.Int(1),
+			Value: &[]interface{}{
+				security.AccessControlList{
+					Token:              converter.String(sn.token),
+					InheritPermissions: converter.Bool(inherit),
+					AcesDictionary:     &aces,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to set the inheritance of ACL for token [%s]: %+v", sn.token, err)
+	}
+	sn.inheritPermissions = converter.Bool(inherit)
+	return nil
+}
+
+// GetAccessControlListSubjects returns the subject descriptors of all principals with an ACE for the token of the namespace
+func (sn *SecurityNamespace) GetAccessControlListSubjects() ([]string, error) {
+	acl, err := sn.GetAccessControlList(nil)
+	if err != nil {
+		return nil, err
+	}
+	if acl == nil || acl.AcesDictionary == nil || len(*acl.AcesDictionary) == 0 {
+		return []string{}, nil
+	}
+
+	descriptors := make([]string, 0, len(*acl.AcesDictionary))
+	for descriptor := range *acl.AcesDictionary {
+		descriptors = append(descriptors, descriptor)
+	}
+	sort.Strings(descriptors)
+
+	idlist, err := sn.identityClient.ReadIdentities(sn.context, identity.ReadIdentitiesArgs{
+		Descriptors: converter.String(strings.Join(descriptors, ",")),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get identity details for descriptors [%s]: %+v", strings.Join(descriptors, ","), err)
+	}
+
+	subjects := []string{}
+	if idlist != nil {
+		for _, id := range *idlist {
+			if id.SubjectDescriptor != nil && *id.SubjectDescriptor != "" {
+				subjects = append(subjects, *id.SubjectDescriptor)
+			}
+		}
+	}
+	return subjects, nil
+}
+
 func (sn *SecurityNamespace) tryGetIdentitiesFromSubjects(principal *[]string) (*[]identity.Identity, error) {
 	descriptors := linq.From(*principal).
 		Aggregate(func(r interface{}, i interface{}) interface{} {
@@ -350,6 +468,7 @@ func (sn *SecurityNamespace) SetPrincipalPermissions(permissionList *[]SetPrinci
 	if nil == permissionList || len(*permissionList) == 0 {
 		return nil
 	}
+	defer LockAccessControlList(sn.namespaceID, sn.token)()
 
 	permissionMap := map[string]SetPrincipalPermission{}
 	linq.From(*permissionList).
@@ -556,6 +675,8 @@ func (sn *SecurityNamespace) GetPrincipalPermissions(principal *[]string) (*[]Pr
 
 // RemovePrincipalPermissions removes all permissions for given principals and a Security Namespace token
 func (sn *SecurityNamespace) RemovePrincipalPermissions(principal *[]string) error {
+	defer LockAccessControlList(sn.namespaceID, sn.token)()
+
 	idList, err := sn.getIdentitiesFromSubjects(principal)
 	if err != nil {
 		return err
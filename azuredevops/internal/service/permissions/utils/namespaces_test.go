@@ -11,6 +11,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -768,3 +769,169 @@ func TestSecurityNamespace_GetPrincipalPermissions_Verify(t *testing.T) {
 		assert.True(t, ok)
 	}
 }
+
+func TestSecurityNamespace_GetInheritPermissions_NoAclInherits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	clients := &client.AggregatedClient{
+		SecurityClient: securityClient,
+		IdentityClient: azdosdkmocks.NewMockIdentityClient(ctrl),
+		Ctx:            context.Background(),
+	}
+
+	sn, err := NewSecurityNamespace(nil, clients, SecurityNamespaceIDValues.Project, func(d *schema.ResourceData, clients *client.AggregatedClient) (string, error) {
+		return projectAccessToken, nil
+	})
+	assert.Nil(t, err)
+
+	securityClient.
+		EXPECT().
+		QueryAccessControlLists(clients.Ctx, gomock.Any()).
+		Return(&[]security.AccessControlList{}, nil).
+		Times(1)
+
+	inherit, err := sn.GetInheritPermissions()
+	assert.Nil(t, err)
+	assert.True(t, inherit)
+}
+
+func TestSecurityNamespace_SetInheritPermissions_RetainsAces(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	clients := &client.AggregatedClient{
+		SecurityClient: securityClient,
+		IdentityClient: azdosdkmocks.NewMockIdentityClient(ctrl),
+		Ctx:            context.Background(),
+	}
+
+	sn, err := NewSecurityNamespace(nil, clients, SecurityNamespaceIDValues.Project, func(d *schema.ResourceData, clients *client.AggregatedClient) (string, error) {
+		return projectAccessToken, nil
+	})
+	assert.Nil(t, err)
+
+	securityClient.
+		EXPECT().
+		QueryAccessControlLists(clients.Ctx, gomock.Any()).
+		Return(&projectAccessControlList, nil).
+		Times(1)
+
+	securityClient.
+		EXPECT().
+		SetAccessControlLists(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args security.SetAccessControlListsArgs) error {
+			assert.Equal(t, securityNamespaceDescriptionProjectId, *args.SecurityNamespaceId)
+			assert.Len(t, *args.AccessControlLists.Value, 1)
+
+			acl := (*args.AccessControlLists.Value)[0].(security.AccessControlList)
+			assert.Equal(t, projectAccessToken, *acl.Token)
+			assert.False(t, *acl.InheritPermissions)
+			assert.Len(t, *acl.AcesDictionary, len(*projectAccessControlList[0].AcesDictionary))
+			for descriptor, ace := range *projectAccessControlList[0].AcesDictionary {
+				assert.Equal(t, *ace.Allow, *(*acl.AcesDictionary)[descriptor].Allow)
+				assert.Equal(t, *ace.Deny, *(*acl.AcesDictionary)[descriptor].Deny)
+			}
+			return nil
+		}).
+		Times(1)
+
+	err = sn.SetInheritPermissions(false)
+	assert.Nil(t, err)
+
+	inherit, err := sn.GetInheritPermissions()
+	assert.Nil(t, err)
+	assert.False(t, inherit)
+}
+
+func TestSecurityNamespace_SetInheritPermissions_LocksAccessControlList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	clients := &client.AggregatedClient{
+		SecurityClient: securityClient,
+		IdentityClient: azdosdkmocks.NewMockIdentityClient(ctrl),
+		Ctx:            context.Background(),
+	}
+
+	sn, err := NewSecurityNamespace(nil, clients, SecurityNamespaceIDValues.Project, func(d *schema.ResourceData, clients *client.AggregatedClient) (string, error) {
+		return projectAccessToken, nil
+	})
+	assert.Nil(t, err)
+
+	// an ACE written in parallel for the same token has to wait until the ACL has been written
+	aceWritten := make(chan struct{})
+	securityClient.
+		EXPECT().
+		QueryAccessControlLists(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args security.QueryAccessControlListsArgs) (*[]security.AccessControlList, error) {
+			go func() {
+				defer LockAccessControlList(securityNamespaceDescriptionProjectId, projectAccessToken)()
+				close(aceWritten)
+			}()
+			select {
+			case <-aceWritten:
+				t.Error("ACE written while the inheritance of the ACL is updated")
+			case <-time.After(50 * time.Millisecond):
+			}
+			return &projectAccessControlList, nil
+		}).
+		Times(1)
+	securityClient.
+		EXPECT().
+		SetAccessControlLists(clients.Ctx, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	err = sn.SetInheritPermissions(false)
+	assert.Nil(t, err)
+
+	select {
+	case <-aceWritten:
+	case <-time.After(time.Second):
+		t.Error("ACE not written after the inheritance of the ACL has been updated")
+	}
+}
+
+func TestSecurityNamespace_RestoreInheritPermissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	clients := &client.AggregatedClient{
+		SecurityClient: securityClient,
+		IdentityClient: azdosdkmocks.NewMockIdentityClient(ctrl),
+		Ctx:            context.Background(),
+	}
+
+	sn, err := NewSecurityNamespace(nil, clients, SecurityNamespaceIDValues.Project, func(d *schema.ResourceData, clients *client.AggregatedClient) (string, error) {
+		return projectAccessToken, nil
+	})
+	assert.Nil(t, err)
+
+	d := schema.TestResourceDataRaw(t, CreatePermissionResourceSchema(map[string]*schema.Schema{}), nil)
+	assert.Nil(t, RestoreInheritPermissions(d, sn))
+	d.Set("inherit", true)
+	assert.Nil(t, RestoreInheritPermissions(d, sn))
+
+	securityClient.
+		EXPECT().
+		QueryAccessControlLists(clients.Ctx, gomock.Any()).
+		Return(&projectAccessControlList, nil).
+		Times(1)
+	securityClient.
+		EXPECT().
+		SetAccessControlLists(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args security.SetAccessControlListsArgs) error {
+			acl := (*args.AccessControlLists.Value)[0].(security.AccessControlList)
+			assert.True(t, *acl.InheritPermissions)
+			return nil
+		}).
+		Times(1)
+
+	d.Set("inherit", false)
+	assert.Nil(t, RestoreInheritPermissions(d, sn))
+}
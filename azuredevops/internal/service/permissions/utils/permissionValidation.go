@@ -0,0 +1,172 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+)
+
+// actionDefinitionsCache caches the action definitions of a security namespace, which are the same
+// for all organizations, so that they are only loaded once per namespace during a plan
+var actionDefinitionsCache = struct {
+	sync.Mutex
+	actions map[uuid.UUID]*map[string]security.ActionDefinition
+}{
+	actions: map[uuid.UUID]*map[string]security.ActionDefinition{},
+}
+
+// ValidatePermissionNames returns a CustomizeDiffFunc, which validates the keys of the permissions map
+// against the action definitions of the security namespace, so that invalid permissions fail the plan
+func ValidatePermissionNames(namespaceID SecurityNamespaceID) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if !d.NewValueKnown("permissions") {
+			return nil
+		}
+		permissions := d.Get("permissions").(map[string]interface{})
+
+		names := make([]string, 0, len(permissions))
+		for name := range permissions {
+			names = append(names, name)
+		}
+		return validatePermissionNamesOfNamespace(m, namespaceID, names)
+	}
+}
+
+// ValidateAclPermissionNames returns a CustomizeDiffFunc, which validates the keys of the permissions maps
+// of all permission blocks of an ACL resource against the action definitions of the security namespace
+func ValidateAclPermissionNames(namespaceID SecurityNamespaceID) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if !d.NewValueKnown("permission") {
+			return nil
+		}
+
+		unique := map[string]bool{}
+		for _, raw := range d.Get("permission").(*schema.Set).List() {
+			item, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if permissions, ok := item["permissions"].(map[string]interface{}); ok {
+				for name := range permissions {
+					unique[name] = true
+				}
+			}
+		}
+
+		names := make([]string, 0, len(unique))
+		for name := range unique {
+			names = append(names, name)
+		}
+		return validatePermissionNamesOfNamespace(m, namespaceID, names)
+	}
+}
+
+func validatePermissionNamesOfNamespace(m interface{}, namespaceID SecurityNamespaceID, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	clients, ok := m.(*client.AggregatedClient)
+	if !ok || clients == nil || clients.SecurityClient == nil || clients.Ctx == nil {
+		return nil
+	}
+
+	actions, err := getCachedActionDefinitions(clients, namespaceID)
+	if err != nil {
+		// an unavailable namespace definition must not block the plan, the apply reports invalid permissions as well
+		log.Printf("[WARN] Unable to load the action definitions of security namespace %s, skipping the validation of permissions: %+v", uuid.UUID(namespaceID), err)
+		return nil
+	}
+	return validatePermissionNames(names, actions)
+}
+
+func getCachedActionDefinitions(clients *client.AggregatedClient, namespaceID SecurityNamespaceID) (*map[string]security.ActionDefinition, error) {
+	actionDefinitionsCache.Lock()
+	defer actionDefinitionsCache.Unlock()
+
+	if actions, ok := actionDefinitionsCache.actions[uuid.UUID(namespaceID)]; ok {
+		return actions, nil
+	}
+
+	sn := &SecurityNamespace{
+		namespaceID:    uuid.UUID(namespaceID),
+		context:        clients.Ctx,
+		securityClient: clients.SecurityClient,
+		identityClient: clients.IdentityClient,
+	}
+	actions, err := sn.GetActionDefinitions()
+	if err != nil {
+		return nil, err
+	}
+	actionDefinitionsCache.actions[uuid.UUID(namespaceID)] = actions
+	return actions, nil
+}
+
+func validatePermissionNames(names []string, actions *map[string]security.ActionDefinition) error {
+	validNames := make([]string, 0, len(*actions))
+	for name := range *actions {
+		validNames = append(validNames, name)
+	}
+	sort.Strings(validNames)
+	sort.Strings(names)
+
+	var invalid []string
+	for _, name := range names {
+		if _, ok := (*actions)[name]; ok {
+			continue
+		}
+		if suggestion := closestActionName(name, validNames); suggestion != "" {
+			invalid = append(invalid, fmt.Sprintf("%q (did you mean %q?)", name, suggestion))
+		} else {
+			invalid = append(invalid, fmt.Sprintf("%q", name))
+		}
+	}
+	if len(invalid) == 0 {
+		return nil
+	}
+	return fmt.Errorf("Invalid permission %s, valid permissions are %s", strings.Join(invalid, ", "), strings.Join(validNames, ", "))
+}
+
+// closestActionName returns the action name with the smallest edit distance to name, or an empty string
+// if no action name is similar enough to be a likely typo
+func closestActionName(name string, validNames []string) string {
+	closest := ""
+	closestDistance := len(name)/2 + 1
+	for _, validName := range validNames {
+		distance := levenshteinDistance(strings.ToLower(name), strings.ToLower(validName))
+		if distance < closestDistance {
+			closest = validName
+			closestDistance = distance
+		}
+	}
+	return closest
+}
+
+func levenshteinDistance(a string, b string) int {
+	s, t := []rune(a), []rune(b)
+	previous := make([]int, len(t)+1)
+	current := make([]int, len(t)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		current[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(t)]
+}
@@ -0,0 +1,82 @@
+//go:build (all || utils || securitynamespaces) && !exclude_securitynamespaces
+// +build all utils securitynamespaces
+// +build !exclude_securitynamespaces
+
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var validationActions = map[string]security.ActionDefinition{
+	"GenericRead":       {Name: converter.String("GenericRead"), Bit: converter.Int(2)},
+	"GenericContribute": {Name: converter.String("GenericContribute"), Bit: converter.Int(4)},
+	"ForcePush":         {Name: converter.String("ForcePush"), Bit: converter.Int(8)},
+}
+
+func TestPermissionValidation_ValidNames(t *testing.T) {
+	err := validatePermissionNames([]string{"GenericRead", "ForcePush"}, &validationActions)
+	assert.Nil(t, err)
+}
+
+func TestPermissionValidation_InvalidNameSuggestsClosestName(t *testing.T) {
+	err := validatePermissionNames([]string{"GenericContibute", "GenericRead"}, &validationActions)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `"GenericContibute" (did you mean "GenericContribute"?)`)
+	assert.Contains(t, err.Error(), "valid permissions are ForcePush, GenericContribute, GenericRead")
+	assert.NotContains(t, err.Error(), `"GenericRead"`)
+}
+
+func TestPermissionValidation_CaseMismatchSuggestsActionName(t *testing.T) {
+	err := validatePermissionNames([]string{"forcepush"}, &validationActions)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `"forcepush" (did you mean "ForcePush"?)`)
+}
+
+func TestPermissionValidation_UnrelatedNameHasNoSuggestion(t *testing.T) {
+	err := validatePermissionNames([]string{"ManageWiki"}, &validationActions)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `Invalid permission "ManageWiki", valid permissions are`)
+	assert.NotContains(t, err.Error(), "did you mean")
+}
+
+func TestPermissionValidation_ActionDefinitionsAreCachedPerNamespace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	clients := &client.AggregatedClient{
+		SecurityClient: securityClient,
+		IdentityClient: azdosdkmocks.NewMockIdentityClient(ctrl),
+		Ctx:            context.Background(),
+	}
+
+	securityClient.
+		EXPECT().
+		QuerySecurityNamespaces(clients.Ctx, security.QuerySecurityNamespacesArgs{
+			SecurityNamespaceId: &securityNamespaceDescriptionProjectId,
+		}).
+		Return(&securityNamespaceDescriptionProject, nil).
+		Times(1)
+
+	for i := 0; i < 2; i++ {
+		actions, err := getCachedActionDefinitions(clients, SecurityNamespaceIDValues.Project)
+		assert.Nil(t, err)
+		assert.Equal(t, len(*securityNamespaceDescriptionProject[0].Actions), len(*actions))
+	}
+}
+
+func TestPermissionValidation_LevenshteinDistance(t *testing.T) {
+	assert.Equal(t, 0, levenshteinDistance("read", "read"))
+	assert.Equal(t, 1, levenshteinDistance("genericcontibute", "genericcontribute"))
+	assert.Equal(t, 3, levenshteinDistance("kitten", "sitting"))
+	assert.Equal(t, 4, levenshteinDistance("", "read"))
+}
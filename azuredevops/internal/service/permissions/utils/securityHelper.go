@@ -87,6 +87,14 @@ func SetPrincipalPermissions(d *schema.ResourceData, sn *SecurityNamespace, forc
 		return fmt.Errorf("waiting for permission update. %v ", err)
 	}
 
+	if forcePermission == nil {
+		if err := SetInheritPermissions(d, sn); err != nil {
+			return err
+		}
+	} else if err := RestoreInheritPermissions(d, sn); err != nil {
+		return err
+	}
+
 	d.SetId(fmt.Sprintf("%s/%s", sn.token, principal.(string)))
 	return nil
 }
@@ -119,5 +127,46 @@ func GetPrincipalPermissions(d *schema.ResourceData, sn *SecurityNamespace) (*Pr
 			delete(((*principalPermissions)[0]).Permissions, key)
 		}
 	}
+	if err := readInheritPermissions(d, sn); err != nil {
+		return nil, err
+	}
 	return &(*principalPermissions)[0], nil
 }
+
+// SetInheritPermissions sets the inheritance of the token, if the inherit attribute is configured
+func SetInheritPermissions(d *schema.ResourceData, sn *SecurityNamespace) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	inherit := rawConfig.GetAttr("inherit")
+	if inherit.IsNull() || !inherit.IsKnown() {
+		return nil
+	}
+
+	current, err := sn.GetInheritPermissions()
+	if err != nil {
+		return err
+	}
+	if current == inherit.True() {
+		return nil
+	}
+	return sn.SetInheritPermissions(inherit.True())
+}
+
+// RestoreInheritPermissions turns the inheritance of the token on again when a resource breaking it is destroyed
+func RestoreInheritPermissions(d *schema.ResourceData, sn *SecurityNamespace) error {
+	if inherit, ok := d.GetOkExists("inherit"); !ok || inherit.(bool) { //nolint:staticcheck
+		return nil
+	}
+	return sn.SetInheritPermissions(true)
+}
+
+func readInheritPermissions(d *schema.ResourceData, sn *SecurityNamespace) error {
+	inherit, err := sn.GetInheritPermissions()
+	if err != nil {
+		return err
+	}
+	d.Set("inherit", inherit)
+	return nil
+}
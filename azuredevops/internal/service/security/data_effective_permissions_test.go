@@ -0,0 +1,37 @@
+//go:build (all || security || data_sources || data_effective_permissions) && (!exclude_data_sources || !exclude_security || !exclude_data_effective_permissions)
+// +build all security data_sources data_effective_permissions
+// +build !exclude_data_sources !exclude_security !exclude_data_effective_permissions
+
+package security
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var effectivePermissionsActions = []security.ActionDefinition{
+	{Name: converter.String("GENERIC_READ"), Bit: converter.Int(1)},
+	{Name: converter.String("GENERIC_WRITE"), Bit: converter.Int(2)},
+	{Name: converter.String("DELETE"), Bit: converter.Int(4)},
+}
+
+func TestDataEffectivePermissions_FlattenPermissionBits(t *testing.T) {
+	result := flattenPermissionBits(&effectivePermissionsActions, converter.Int(1|4), converter.Int(4))
+	require.Equal(t, map[string]interface{}{
+		"GENERIC_READ":  "allow",
+		"GENERIC_WRITE": "notset",
+		"DELETE":        "deny",
+	}, result)
+}
+
+func TestDataEffectivePermissions_FlattenPermissionBits_NoBits(t *testing.T) {
+	result := flattenPermissionBits(&effectivePermissionsActions, nil, nil)
+	require.Equal(t, map[string]interface{}{
+		"GENERIC_READ":  "notset",
+		"GENERIC_WRITE": "notset",
+		"DELETE":        "notset",
+	}, result)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/security"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
 )
 
 // ResourceGenericPermissions schema and implementation for generic permission resource
@@ -65,6 +66,12 @@ func ResourceGenericPermissions() *schema.Resource {
 				Default:     true,
 				Description: "Replace existing permissions (true) or merge with existing (true)",
 			},
+			"inherit": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the token inherits permissions from its parent tokens",
+			},
 		},
 	}
 }
@@ -159,10 +166,12 @@ func resourceGenericPermissionsCreateOrUpdate(d *schema.ResourceData, m interfac
 	}
 
 	// Set ACL
+	unlock := utils.LockAccessControlList(namespaceID, token)
 	_, err = clients.SecurityClient.SetAccessControlEntries(clients.Ctx, security.SetAccessControlEntriesArgs{
 		SecurityNamespaceId: &namespaceID,
 		Container:           container,
 	})
+	unlock()
 	if err != nil {
 		return fmt.Errorf("setting permissions: %v", err)
 	}
@@ -234,6 +243,16 @@ func resourceGenericPermissionsCreateOrUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("waiting for permission update: %v", err)
 	}
 
+	sn, err := utils.NewSecurityNamespace(d, clients, utils.SecurityNamespaceID(namespaceID), func(*schema.ResourceData, *client.AggregatedClient) (string, error) {
+		return token, nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := utils.SetInheritPermissions(d, sn); err != nil {
+		return fmt.Errorf("setting inheritance: %v", err)
+	}
+
 	d.SetId(fmt.Sprintf("%s/%s/%s", namespaceID.String(), token, principal))
 	return resourceGenericPermissionsRead(d, m)
 }
@@ -344,6 +363,7 @@ func resourceGenericPermissionsRead(d *schema.ResourceData, m interface{}) error
 	if err := d.Set("permissions", currentPermissions); err != nil {
 		return fmt.Errorf("setting permissions in state: %v", err)
 	}
+	d.Set("inherit", acl.InheritPermissions == nil || *acl.InheritPermissions)
 	return nil
 }
 
@@ -472,10 +492,12 @@ func resourceGenericPermissionsDelete(d *schema.ResourceData, m interface{}) err
 		AccessControlEntries: &[]security.AccessControlEntry{updatedACE},
 	}
 
+	unlock := utils.LockAccessControlList(namespaceID, token)
 	_, err = clients.SecurityClient.SetAccessControlEntries(clients.Ctx, security.SetAccessControlEntriesArgs{
 		SecurityNamespaceId: &namespaceID,
 		Container:           container,
 	})
+	unlock()
 	if err != nil {
 		return fmt.Errorf("removing managed permissions: %v", err)
 	}
@@ -535,6 +557,16 @@ func resourceGenericPermissionsDelete(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("waiting for permission removal: %v", err)
 	}
 
+	sn, err := utils.NewSecurityNamespace(d, clients, utils.SecurityNamespaceID(namespaceID), func(*schema.ResourceData, *client.AggregatedClient) (string, error) {
+		return token, nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := utils.RestoreInheritPermissions(d, sn); err != nil {
+		return fmt.Errorf("restoring inheritance: %v", err)
+	}
+
 	log.Printf("[INFO] Successfully removed managed permissions from ACE for principal %s", principal)
 	return nil
 }
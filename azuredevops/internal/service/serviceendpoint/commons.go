@@ -1,12 +1,15 @@
 package serviceendpoint
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,6 +17,7 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 const (
@@ -60,7 +64,161 @@ func baseSchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"adopt_existing_credentials": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+// suppressAdoptedCredential ignores the secrets of an adopted service endpoint which are not set in the configuration.
+// Secrets are not returned by the service, so imported or adopted service endpoints have no secrets in the state.
+// Configured secrets are sent as usual, the others are sent again once `adopt_existing_credentials` is turned off.
+func suppressAdoptedCredential(k, _, _ string, d *schema.ResourceData) bool {
+	if d.Id() == "" || !d.Get("adopt_existing_credentials").(bool) {
+		return false
+	}
+	return isConfiguredValueEmpty(d.GetRawConfig(), k)
+}
+
+// isConfiguredValueEmpty returns whether the value at the flatmap key k is unset or empty in the configuration.
+// Values which are not known yet and values in sets are not considered empty.
+func isConfiguredValueEmpty(config cty.Value, k string) bool {
+	value := config
+	parts := strings.Split(k, ".")
+	for i, part := range parts {
+		if !value.IsKnown() {
+			return false
+		}
+		if value.IsNull() {
+			return true
+		}
+
+		valueType := value.Type()
+		switch {
+		case valueType.IsObjectType():
+			if !valueType.HasAttribute(part) {
+				return true
+			}
+			value = value.GetAttr(part)
+		case valueType.IsListType():
+			index, err := strconv.Atoi(part)
+			if err != nil {
+				return false
+			}
+			if index >= value.LengthInt() {
+				return true
+			}
+			value = value.Index(cty.NumberIntVal(int64(index)))
+		case valueType.IsMapType():
+			if part == "%" {
+				return value.LengthInt() == 0
+			}
+			key := cty.StringVal(strings.Join(parts[i:], "."))
+			if value.HasIndex(key).False() {
+				return true
+			}
+			return isEmptyValue(value.Index(key))
+		default:
+			return false
+		}
+	}
+	return isEmptyValue(value)
+}
+
+func isEmptyValue(value cty.Value) bool {
+	if !value.IsKnown() {
+		return false
+	}
+	if value.IsNull() {
+		return true
+	}
+	if value.Type() == cty.String {
+		return value.AsString() == ""
+	}
+	if value.Type().IsMapType() || value.Type().IsListType() {
+		return value.LengthInt() == 0
+	}
+	return false
+}
+
+// importServiceEndpoint imports a service endpoint given <projectNameOrID>/<serviceEndpointNameOrID>
+func importServiceEndpoint() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			parts := strings.SplitN(d.Id(), "/", 2)
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				return nil, fmt.Errorf("Unexpected format of ID (%s), expected <projectNameOrID>/<serviceEndpointNameOrID>", d.Id())
+			}
+
+			projectID, err := tfhelper.GetRealProjectId(parts[0], m)
+			if err != nil {
+				return nil, err
+			}
+
+			serviceEndpointID := parts[1]
+			if _, err := uuid.Parse(serviceEndpointID); err != nil {
+				clients := m.(*client.AggregatedClient)
+				serviceEndpoint, err := dataSourceGetServiceEndpointByNameAndProject(clients, serviceEndpointID, projectID)
+				if err != nil {
+					return nil, fmt.Errorf("Looking up service endpoint with name (%s) and projectID (%s): %+v", serviceEndpointID, projectID, err)
+				}
+				if serviceEndpoint.Id == nil {
+					return nil, fmt.Errorf("Service endpoint with name (%s) in project (%s) has no ID", serviceEndpointID, projectID)
+				}
+				serviceEndpointID = serviceEndpoint.Id.String()
+			}
+
+			d.Set("project_id", projectID)
+			d.SetId(serviceEndpointID)
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// credentialRotationSchema returns the attributes used to rotate the credentials of a service endpoint. Secrets
+// are not returned by the service, changing any of these attributes sends the configured credentials again.
+func credentialRotationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"secret_version": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"rotation_trigger": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"validate_rotation": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+// credentialsRotated reports whether the planned update rotates the credentials of the service endpoint
+func credentialsRotated(d *schema.ResourceData) bool {
+	return d.Id() != "" && d.HasChanges("secret_version", "rotation_trigger")
+}
+
+// validateRotatedServiceEndpoint verifies that the rotated credentials of a service endpoint work before they are sent
+// to the service. If they do not, the service endpoint is not updated and the rotation attributes keep their previous
+// values so that the next apply validates the credentials again.
+func validateRotatedServiceEndpoint(d *schema.ResourceData, clients *client.AggregatedClient, endpoint *serviceendpoint.ServiceEndpoint) error {
+	if !credentialsRotated(d) || !d.Get("validate_rotation").(bool) {
+		return nil
 	}
+
+	if err := validateServiceEndpoint(clients, endpoint, d.Get("project_id").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		d.Partial(true)
+		return fmt.Errorf("Validating the rotated credentials of service endpoint %s: %+v", d.Id(), err)
+	}
+	return nil
 }
 
 func createServiceEndpoint(d *schema.ResourceData, clients *client.AggregatedClient, endpoint *serviceendpoint.ServiceEndpoint) (*serviceendpoint.ServiceEndpoint, error) {
@@ -79,39 +237,93 @@ func createServiceEndpoint(d *schema.ResourceData, clients *client.AggregatedCli
 	}
 
 	projectID := (*endpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id
+	if err := waitForServiceEndpointReady(clients, createdServiceEndpoint, projectID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return nil, cleanupFailedServiceEndpoint(d, clients, createdServiceEndpoint, err)
+	}
+
+	return createdServiceEndpoint, nil
+}
 
+// waitForServiceEndpointReady waits until a created service endpoint is ready. Service endpoints provisioning
+// resources, e.g. the service principal of an AzureRM service endpoint, fail with a serviceEndpointOperationError.
+func waitForServiceEndpointReady(clients *client.AggregatedClient, endpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		ContinuousTargetOccurence: 1,
 		Delay:                     10 * time.Second,
 		MinTimeout:                10 * time.Second,
 		Pending:                   []string{opState.InProgress},
 		Target:                    []string{opState.Ready, opState.Failed},
-		Refresh:                   getServiceEndpoint(clients, createdServiceEndpoint.Id, projectID),
-		Timeout:                   d.Timeout(schema.TimeoutCreate),
+		Refresh:                   getServiceEndpoint(clients, endpoint.Id, projectID),
+		Timeout:                   timeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil { //nolint:staticcheck
-		if delErr := deleteServiceEndpoint(clients, createdServiceEndpoint, d.Timeout(schema.TimeoutDelete)); delErr != nil {
-			log.Printf("[DEBUG] Failed to delete the failed service endpoint: %v ", delErr)
+	if _, err := stateConf.WaitForStateContext(clients.Ctx); err != nil {
+		var opErr *serviceEndpointOperationError
+		if errors.As(err, &opErr) {
+			return opErr
 		}
-		return nil, fmt.Errorf("waiting for service endpoint ready. %v ", err)
+		return fmt.Errorf("waiting for service endpoint ready. %v ", err)
 	}
+	return nil
+}
 
-	return createdServiceEndpoint, err
+// cleanupFailedServiceEndpoint deletes a service endpoint whose operation failed, so that no orphaned service endpoint
+// is left behind. A service endpoint which did not fail, e.g. because waiting for it timed out while it was still in
+// progress, is kept in the state. Terraform marks it as tainted and replaces it on the next apply.
+func cleanupFailedServiceEndpoint(d *schema.ResourceData, clients *client.AggregatedClient, endpoint *serviceendpoint.ServiceEndpoint, err error) error {
+	var opErr *serviceEndpointOperationError
+	if !errors.As(err, &opErr) || opErr.State != opState.Failed {
+		d.SetId(endpoint.Id.String())
+		return fmt.Errorf("%w. The service endpoint %s has not failed and is kept, it is replaced on the next apply", err, endpoint.Id)
+	}
+
+	if endpoint.ServiceEndpointProjectReferences == nil || len(*endpoint.ServiceEndpointProjectReferences) == 0 {
+		return fmt.Errorf("%w. The failed service endpoint %s has no project reference, it has to be deleted manually", err, endpoint.Id)
+	}
+	if delErr := deleteServiceEndpoint(clients, endpoint, d.Timeout(schema.TimeoutDelete)); delErr != nil {
+		log.Printf("[DEBUG] Failed to delete the failed service endpoint: %v ", delErr)
+		return fmt.Errorf("%w. Deleting the failed service endpoint %s failed as well, it has to be deleted manually: %v", err, endpoint.Id, delErr)
+	}
+	return fmt.Errorf("%w. The failed service endpoint %s has been deleted", err, endpoint.Id)
 }
 
-func updateServiceEndpoint(clients *client.AggregatedClient, endpoint *serviceendpoint.ServiceEndpoint) (*serviceendpoint.ServiceEndpoint, error) {
-	updatedServiceEndpoint, err := clients.ServiceEndpointClient.UpdateServiceEndpoint(
-		clients.Ctx,
-		serviceendpoint.UpdateServiceEndpointArgs{
-			Endpoint:   endpoint,
-			EndpointId: endpoint.Id,
-		},
-	)
+func updateServiceEndpoint(d *schema.ResourceData, clients *client.AggregatedClient, endpoint *serviceendpoint.ServiceEndpoint) (*serviceendpoint.ServiceEndpoint, error) {
+	return updateServiceEndpointWithOperation(d, clients, endpoint, "")
+}
+
+// updateServiceEndpointWithOperation updates the service endpoint, the operation is passed to the service if it is set
+func updateServiceEndpointWithOperation(d *schema.ResourceData, clients *client.AggregatedClient, endpoint *serviceendpoint.ServiceEndpoint, operation string) (*serviceendpoint.ServiceEndpoint, error) {
+	omitAdoptedCredentials(d, endpoint)
+	if err := keepSharedProjectReferences(clients, endpoint); err != nil {
+		return nil, err
+	}
+	args := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   endpoint,
+		EndpointId: endpoint.Id,
+	}
+	if operation != "" {
+		args.Operation = converter.String(operation)
+	}
+	updatedServiceEndpoint, err := clients.ServiceEndpointClient.UpdateServiceEndpoint(clients.Ctx, args)
 
 	return updatedServiceEndpoint, err
 }
 
+// omitAdoptedCredentials removes the secrets unknown to Terraform from an adopted service endpoint, the service
+// keeps the stored values of authorization parameters which are not sent
+func omitAdoptedCredentials(d *schema.ResourceData, endpoint *serviceendpoint.ServiceEndpoint) {
+	if !d.Get("adopt_existing_credentials").(bool) || endpoint.Authorization == nil || endpoint.Authorization.Parameters == nil {
+		return
+	}
+	parameters := map[string]string{}
+	for k, v := range *endpoint.Authorization.Parameters {
+		if v != "" {
+			parameters[k] = v
+		}
+	}
+	endpoint.Authorization.Parameters = &parameters
+}
+
 func deleteServiceEndpoint(clients *client.AggregatedClient, serviceEndpoint *serviceendpoint.ServiceEndpoint, timeout time.Duration) error {
 	projectID := (*serviceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id
 	if err := clients.ServiceEndpointClient.DeleteServiceEndpoint(
@@ -142,6 +354,111 @@ func deleteServiceEndpoint(clients *client.AggregatedClient, serviceEndpoint *se
 	return nil
 }
 
+// keepSharedProjectReferences adds the project references of the projects the service endpoint is shared with
+// to the update, so that updating a service endpoint keeps it shared, e.g. by azuredevops_serviceendpoint_share
+func keepSharedProjectReferences(clients *client.AggregatedClient, endpoint *serviceendpoint.ServiceEndpoint) error {
+	if endpoint.Id == nil || endpoint.ServiceEndpointProjectReferences == nil || len(*endpoint.ServiceEndpointProjectReferences) == 0 {
+		return nil
+	}
+	projectID := (*endpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id
+	current, err := getProjectServiceEndpoint(clients, endpoint.Id, projectID.String())
+	if err != nil {
+		return fmt.Errorf(errMsgServiceCreate, endpoint.Id, projectID, err)
+	}
+	if current == nil || current.ServiceEndpointProjectReferences == nil {
+		return nil
+	}
+
+	references := append([]serviceendpoint.ServiceEndpointProjectReference{}, *endpoint.ServiceEndpointProjectReferences...)
+	for _, reference := range *current.ServiceEndpointProjectReferences {
+		if reference.ProjectReference == nil || reference.ProjectReference.Id == nil ||
+			findServiceEndpointProjectReference(endpoint, reference.ProjectReference.Id.String()) != nil {
+			continue
+		}
+		references = append(references, reference)
+	}
+	endpoint.ServiceEndpointProjectReferences = &references
+	return nil
+}
+
+// getProjectServiceEndpoint returns the service endpoint of the project, or nil if it does not exist
+func getProjectServiceEndpoint(clients *client.AggregatedClient, endpointID *uuid.UUID, projectID string) (*serviceendpoint.ServiceEndpoint, error) {
+	endpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+		EndpointId: endpointID,
+		Project:    converter.String(projectID),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if endpoint == nil || endpoint.Id == nil {
+		return nil, nil
+	}
+	return endpoint, nil
+}
+
+// findServiceEndpointProjectReference returns the reference of the service endpoint to the project, or nil if the
+// service endpoint is not shared with the project
+func findServiceEndpointProjectReference(endpoint *serviceendpoint.ServiceEndpoint, projectID string) *serviceendpoint.ServiceEndpointProjectReference {
+	if endpoint.ServiceEndpointProjectReferences == nil {
+		return nil
+	}
+	for i, reference := range *endpoint.ServiceEndpointProjectReferences {
+		if reference.ProjectReference != nil && reference.ProjectReference.Id != nil &&
+			strings.EqualFold(reference.ProjectReference.Id.String(), projectID) {
+			return &(*endpoint.ServiceEndpointProjectReferences)[i]
+		}
+	}
+	return nil
+}
+
+// expandServiceEndpointProjectReferences returns the references, which share a service endpoint with the projects
+func expandServiceEndpointProjectReferences(projectIDs []string, name string, description string) ([]serviceendpoint.ServiceEndpointProjectReference, error) {
+	references := make([]serviceendpoint.ServiceEndpointProjectReference, 0, len(projectIDs))
+	for _, projectID := range projectIDs {
+		id, err := uuid.Parse(projectID)
+		if err != nil {
+			return nil, fmt.Errorf("Parsing project ID %s: %v", projectID, err)
+		}
+		references = append(references, serviceendpoint.ServiceEndpointProjectReference{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: &id,
+			},
+			Name:        converter.String(name),
+			Description: converter.String(description),
+		})
+	}
+	return references, nil
+}
+
+func shareServiceEndpoint(clients *client.AggregatedClient, endpointID *uuid.UUID, references []serviceendpoint.ServiceEndpointProjectReference) error {
+	if len(references) == 0 {
+		return nil
+	}
+	return clients.ServiceEndpointClient.ShareServiceEndpoint(clients.Ctx, serviceendpoint.ShareServiceEndpointArgs{
+		EndpointProjectReferences: &references,
+		EndpointId:                endpointID,
+	})
+}
+
+// unshareServiceEndpoint removes the service endpoint from the projects. Removing the service endpoint from the
+// last project it is referenced by deletes the service endpoint.
+func unshareServiceEndpoint(clients *client.AggregatedClient, endpointID *uuid.UUID, projectIDs []string) error {
+	if len(projectIDs) == 0 {
+		return nil
+	}
+	err := clients.ServiceEndpointClient.DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+		EndpointId: endpointID,
+		ProjectIds: &projectIDs,
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 func validateServiceEndpoint(clients *client.AggregatedClient, endpoint *serviceendpoint.ServiceEndpoint, projectId string, retryTimeout time.Duration) error {
 	reqArgs := serviceendpoint.ExecuteServiceEndpointRequestArgs{
 		ServiceEndpointRequest: &serviceendpoint.ServiceEndpointRequest{
@@ -194,6 +511,35 @@ func serviceEndpointGetArgs(d *schema.ResourceData) (*serviceendpoint.GetService
 	}, nil
 }
 
+// serviceEndpointOperationError is returned when the operation of a service endpoint failed
+type serviceEndpointOperationError struct {
+	ServiceEndpointID string
+	ProjectID         string
+	State             string
+	StatusMessage     string
+}
+
+func (e *serviceEndpointOperationError) Error() string {
+	if e.StatusMessage == "" {
+		return fmt.Sprintf("Service endpoint %s in project %s is in state %s", e.ServiceEndpointID, e.ProjectID, e.State)
+	}
+	return fmt.Sprintf("Service endpoint %s in project %s is in state %s: %s", e.ServiceEndpointID, e.ProjectID, e.State, e.StatusMessage)
+}
+
+// getServiceEndpointOperationStatus returns the state and the status message of the operation of a service endpoint
+func getServiceEndpointOperationStatus(endpoint *serviceendpoint.ServiceEndpoint) (string, string) {
+	if endpoint == nil || endpoint.OperationStatus == nil {
+		return "", ""
+	}
+	operationStatus, ok := endpoint.OperationStatus.(map[string]interface{})
+	if !ok {
+		return "", ""
+	}
+	state, _ := operationStatus["state"].(string)
+	statusMessage, _ := operationStatus["statusMessage"].(string)
+	return state, statusMessage
+}
+
 // Service endpoint delete is an async operation, make sure service endpoint is deleted.
 func checkServiceEndpointStatus(clients *client.AggregatedClient, projectID *uuid.UUID, endPointID *uuid.UUID) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -207,12 +553,17 @@ func checkServiceEndpointStatus(clients *client.AggregatedClient, projectID *uui
 		if err != nil {
 			return nil, opState.Failed, fmt.Errorf(errMsgServiceDelete, endPointID, *projectID, err)
 		}
-		if serviceEndpoint != nil && serviceEndpoint.OperationStatus != nil {
-			opStatus := serviceEndpoint.OperationStatus.(map[string]interface{})["state"]
-			if opStatus == opState.Failed {
-				return nil, opState.Failed, fmt.Errorf(errMsgServiceDelete, endPointID, *projectID, serviceEndpoint.OperationStatus)
+		state, statusMessage := getServiceEndpointOperationStatus(serviceEndpoint)
+		if state == opState.Failed {
+			return nil, opState.Failed, &serviceEndpointOperationError{
+				ServiceEndpointID: endPointID.String(),
+				ProjectID:         projectID.String(),
+				State:             state,
+				StatusMessage:     statusMessage,
 			}
-			return serviceendpoint.ServiceEndpoint{}, opStatus.(string), nil
+		}
+		if state != "" {
+			return serviceendpoint.ServiceEndpoint{}, state, nil
 		}
 		return serviceendpoint.ServiceEndpoint{}, opState.Ready, nil
 	}
@@ -230,17 +581,26 @@ func getServiceEndpoint(client *client.AggregatedClient, serviceEndpointID *uuid
 		if err != nil {
 			return nil, opState.Failed, fmt.Errorf(errMsgServiceCreate, serviceEndpointID, *projectID, err)
 		}
+		if serviceEndpoint == nil {
+			return nil, opState.Failed, fmt.Errorf(errMsgServiceCreate, serviceEndpointID, *projectID, "service endpoint not found")
+		}
 
-		if *serviceEndpoint.IsReady {
+		if converter.ToBool(serviceEndpoint.IsReady, false) {
 			return serviceEndpoint, opState.Ready, nil
-		} else if serviceEndpoint.OperationStatus != nil {
-			opStatus := serviceEndpoint.OperationStatus.(map[string]interface{})["state"]
-			if opStatus == opState.Failed {
-				return nil, opState.Failed, fmt.Errorf(errMsgServiceCreate, serviceEndpointID, *projectID, serviceEndpoint.OperationStatus)
+		}
+		state, statusMessage := getServiceEndpointOperationStatus(serviceEndpoint)
+		if state == "" {
+			state, statusMessage = "NotReady", "the service endpoint reports no operation status"
+		}
+		if state == "NotReady" || state == opState.Failed {
+			return nil, opState.Failed, &serviceEndpointOperationError{
+				ServiceEndpointID: serviceEndpointID.String(),
+				ProjectID:         projectID.String(),
+				State:             state,
+				StatusMessage:     statusMessage,
 			}
-			return nil, opStatus.(string), nil
 		}
-		return nil, opState.Failed, fmt.Errorf(errMsgServiceCreate, serviceEndpointID, *projectID, serviceEndpoint.OperationStatus)
+		return nil, state, nil
 	}
 }
 
@@ -347,15 +707,11 @@ func dataSourceGenBaseSchema() map[string]*schema.Schema {
 func dataSourceGetBaseServiceEndpoint(d *schema.ResourceData, m interface{}) (*serviceendpoint.ServiceEndpoint, error) {
 	clients := m.(*client.AggregatedClient)
 
-	var projectID *uuid.UUID
-	projectIDString := d.Get("project_id").(string)
-	parsedProjectID, err := uuid.Parse(projectIDString)
+	projectID, err := dataSourceGetProjectID(d)
 	if err != nil {
-		return nil, fmt.Errorf("Parsing projectID from the Terraform data source declaration: %v", err)
+		return nil, err
 	}
 
-	projectID = &parsedProjectID
-
 	if serviceEndpointIDString, ok := d.GetOk("service_endpoint_id"); ok {
 		var serviceEndpointID *uuid.UUID
 		parsedServiceEndpointID, err := uuid.Parse(serviceEndpointIDString.(string))
@@ -397,6 +753,41 @@ func dataSourceGetBaseServiceEndpoint(d *schema.ResourceData, m interface{}) (*s
 	return nil, nil
 }
 
+func dataSourceGetProjectID(d *schema.ResourceData) (*uuid.UUID, error) {
+	projectID, err := uuid.Parse(d.Get("project_id").(string))
+	if err != nil {
+		return nil, fmt.Errorf("Parsing projectID from the Terraform data source declaration: %v", err)
+	}
+	return &projectID, nil
+}
+
+// dataSourceGetServiceEndpoints returns the service endpoints of the project matching the type, owner and
+// authorization scheme. Empty filters match all service endpoints.
+func dataSourceGetServiceEndpoints(clients *client.AggregatedClient, projectID *uuid.UUID, endpointType string, owner string, authScheme string, includeFailed bool) (*[]serviceendpoint.ServiceEndpoint, error) {
+	args := serviceendpoint.GetServiceEndpointsArgs{
+		Project:       converter.String(projectID.String()),
+		IncludeFailed: converter.Bool(includeFailed),
+	}
+	if endpointType != "" {
+		args.Type = converter.String(endpointType)
+	}
+	if owner != "" {
+		args.Owner = converter.String(owner)
+	}
+	if authScheme != "" {
+		args.AuthSchemes = &[]string{authScheme}
+	}
+
+	serviceEndpoints, err := clients.ServiceEndpointClient.GetServiceEndpoints(clients.Ctx, args)
+	if err != nil {
+		return nil, fmt.Errorf("Looking up service endpoints with projectID (%v): %v", projectID, err)
+	}
+	if serviceEndpoints == nil {
+		return &[]serviceendpoint.ServiceEndpoint{}, nil
+	}
+	return serviceEndpoints, nil
+}
+
 func dataSourceGetServiceEndpointByNameAndProject(clients *client.AggregatedClient, serviceEndpointName string, projectID string) (*serviceendpoint.ServiceEndpoint, error) {
 	serviceEndpointNameList := &[]string{serviceEndpointName}
 
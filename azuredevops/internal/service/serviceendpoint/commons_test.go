@@ -0,0 +1,421 @@
+//go:build (all || serviceendpoint_commons) && !exclude_serviceendpoints
+// +build all serviceendpoint_commons
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	commonsTestServiceEndpointID          = uuid.New()
+	commonsRandomServiceEndpointProjectID = uuid.New()
+	commonsTestServiceEndpointProjectID   = &commonsRandomServiceEndpointProjectID
+)
+
+// commonsTestServiceEndpoint is a Terraform Cloud service endpoint, it stands in for every typed service endpoint
+// built on the helpers in commons.go
+var commonsTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"apitoken": "UNIT_TEST_TOKEN",
+		},
+		Scheme: converter.String("Token"),
+	},
+	Id:          &commonsTestServiceEndpointID,
+	Name:        converter.String("UNIT_TEST_CONN_NAME"),
+	Owner:       converter.String("library"),
+	Type:        converter.String("terraformcloud"),
+	Url:         converter.String("https://app.terraform.io"),
+	Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+	Data: &map[string]string{
+		"organization": "UNIT_TEST_ORGANIZATION",
+	},
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: commonsTestServiceEndpointProjectID,
+			},
+			Name:        converter.String("UNIT_TEST_CONN_NAME"),
+			Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+	},
+}
+
+// verifies that updating a service endpoint keeps the projects it has been shared with
+func TestServiceEndpoint_Update_KeepsSharedProjectReferences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: endpointClient, Ctx: context.Background()}
+
+	id := uuid.New()
+	projectID := uuid.New()
+	sharedProjectID := uuid.New()
+	ownReference := serviceendpoint.ServiceEndpointProjectReference{
+		ProjectReference: &serviceendpoint.ProjectReference{Id: &projectID},
+		Name:             converter.String("updated"),
+	}
+	sharedReference := serviceendpoint.ServiceEndpointProjectReference{
+		ProjectReference: &serviceendpoint.ProjectReference{Id: &sharedProjectID},
+		Name:             converter.String("shared"),
+	}
+
+	endpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: &id,
+			Project:    converter.String(projectID.String()),
+		}).
+		Return(&serviceendpoint.ServiceEndpoint{
+			Id: &id,
+			ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+				{ProjectReference: &serviceendpoint.ProjectReference{Id: &projectID}, Name: converter.String("original")},
+				sharedReference,
+			},
+		}, nil).
+		Times(1)
+	endpointClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args serviceendpoint.UpdateServiceEndpointArgs) (*serviceendpoint.ServiceEndpoint, error) {
+			require.Equal(t, []serviceendpoint.ServiceEndpointProjectReference{ownReference, sharedReference}, *args.Endpoint.ServiceEndpointProjectReferences)
+			return args.Endpoint, nil
+		}).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, baseSchema(), nil)
+	_, err := updateServiceEndpoint(resourceData, clients, &serviceendpoint.ServiceEndpoint{
+		Id:                               &id,
+		ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{ownReference},
+	})
+	require.Nil(t, err)
+}
+
+// verifies that a service endpoint can be imported given the name of the service endpoint
+func TestServiceEndpoint_Import_ByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointTerraformCloud()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId(commonsTestServiceEndpointProjectID.String() + "/UNIT_TEST_CONN_NAME")
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetServiceEndpointsByNames(clients.Ctx, serviceendpoint.GetServiceEndpointsByNamesArgs{
+			Project:       converter.String(commonsTestServiceEndpointProjectID.String()),
+			EndpointNames: &[]string{"UNIT_TEST_CONN_NAME"},
+		}).
+		Return(&[]serviceendpoint.ServiceEndpoint{commonsTestServiceEndpoint}, nil).
+		Times(1)
+
+	imported, err := r.Importer.State(resourceData, clients)
+	require.NoError(t, err)
+	require.Len(t, imported, 1)
+	require.Equal(t, commonsTestServiceEndpointID.String(), imported[0].Id())
+	require.Equal(t, commonsTestServiceEndpointProjectID.String(), imported[0].Get("project_id"))
+}
+
+// verifies that a configured secret of an adopted service endpoint is sent to the service
+func TestServiceEndpoint_AdoptExistingCredentials_DoesNotSuppressConfiguredSecret(t *testing.T) {
+	r := ResourceServiceEndpointTerraformCloud()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("project_id", commonsTestServiceEndpointProjectID.String())
+	flattenServiceEndpointTerraformCloud(resourceData, &commonsTestServiceEndpoint)
+	resourceData.Set("adopt_existing_credentials", true)
+	resourceData.Set("api_token", "")
+	state := resourceData.State()
+
+	diff, err := r.Diff(context.Background(), state, getServiceEndpointResourceConfig(t, r, state, map[string]interface{}{
+		"project_id":                 commonsTestServiceEndpointProjectID.String(),
+		"service_endpoint_name":      "UNIT_TEST_CONN_NAME",
+		"description":                "UNIT_TEST_CONN_DESCRIPTION",
+		"organization":               "UNIT_TEST_ORGANIZATION",
+		"api_token":                  "UNIT_TEST_TOKEN",
+		"adopt_existing_credentials": true,
+	}), nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff.Attributes["api_token"])
+}
+
+// verifies that an unknown secret of an adopted service endpoint is not sent on update
+func TestServiceEndpoint_AdoptExistingCredentials_UpdateOmitsSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointTerraformCloud()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("project_id", commonsTestServiceEndpointProjectID.String())
+	flattenServiceEndpointTerraformCloud(resourceData, &commonsTestServiceEndpoint)
+	resourceData.Set("adopt_existing_credentials", true)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedEndpoint := commonsTestServiceEndpoint
+	expectedEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{},
+		Scheme:     converter.String("Token"),
+	}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(&commonsTestServiceEndpoint, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, serviceendpoint.UpdateServiceEndpointArgs{
+			Endpoint:   &expectedEndpoint,
+			EndpointId: expectedEndpoint.Id,
+		}).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+// verifies that the secrets of an adopted service endpoint stored in a map are ignored unless they are configured,
+// the diff is computed without the type metadata validation of the generic service endpoint
+func TestServiceEndpoint_AdoptExistingCredentials_SuppressesUnsetSecretMap(t *testing.T) {
+	r := ResourceServiceEndpointGenericV2()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"project_id":                 commonsTestServiceEndpointProjectID.String(),
+		"name":                       "UNIT_TEST_CONN_NAME",
+		"type":                       "generic",
+		"server_url":                 "https://example.com",
+		"authorization_scheme":       "UsernamePassword",
+		"adopt_existing_credentials": true,
+	})
+	resourceData.SetId(commonsTestServiceEndpointID.String())
+	resourceData.Set("authorization_parameters", map[string]interface{}{
+		"username": "UNIT_TEST_USERNAME",
+		"password": "UNIT_TEST_PASSWORD",
+	})
+	state := resourceData.State()
+
+	config := map[string]interface{}{
+		"project_id":                 commonsTestServiceEndpointProjectID.String(),
+		"name":                       "UNIT_TEST_CONN_NAME",
+		"type":                       "generic",
+		"server_url":                 "https://example.com",
+		"authorization_scheme":       "UsernamePassword",
+		"adopt_existing_credentials": true,
+	}
+	diff, err := schema.InternalMap(r.Schema).Diff(context.Background(), state, getServiceEndpointResourceConfig(t, r, state, config), nil, nil, true)
+	require.NoError(t, err)
+	require.True(t, diff == nil || diff.Attributes["authorization_parameters.%"] == nil)
+
+	config["authorization_parameters"] = map[string]interface{}{
+		"username": "UNIT_TEST_USERNAME",
+		"password": "UNIT_TEST_ROTATED_PASSWORD",
+	}
+	diff, err = schema.InternalMap(r.Schema).Diff(context.Background(), state, getServiceEndpointResourceConfig(t, r, state, config), nil, nil, true)
+	require.NoError(t, err)
+	require.NotNil(t, diff.Attributes["authorization_parameters.password"])
+
+	delete(config, "authorization_parameters")
+	config["adopt_existing_credentials"] = false
+	diff, err = schema.InternalMap(r.Schema).Diff(context.Background(), state, getServiceEndpointResourceConfig(t, r, state, config), nil, nil, true)
+	require.NoError(t, err)
+	require.NotNil(t, diff.Attributes["authorization_parameters.%"])
+}
+
+// verifies that the secrets of an adopted service endpoint in a nested block which are not configured, e.g. because
+// they default to an environment variable, do not produce a diff
+func TestServiceEndpoint_AdoptExistingCredentials_SuppressesUnsetNestedSecret(t *testing.T) {
+	t.Setenv("AZDO_KUBERNETES_SERVICE_CONNECTION_SERVICE_ACCOUNT_CERT", "UNIT_TEST_CA_CERT")
+	t.Setenv("AZDO_KUBERNETES_SERVICE_CONNECTION_SERVICE_ACCOUNT_TOKEN", "UNIT_TEST_TOKEN")
+
+	r := ResourceServiceEndpointKubernetes()
+	config := map[string]interface{}{
+		"project_id":                 commonsTestServiceEndpointProjectID.String(),
+		"service_endpoint_name":      "UNIT_TEST_CONN_NAME",
+		"apiserver_url":              "https://kubernetes.example.com",
+		"authorization_type":         "ServiceAccount",
+		"service_account":            []interface{}{map[string]interface{}{}},
+		"adopt_existing_credentials": true,
+	}
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, config)
+	resourceData.SetId(commonsTestServiceEndpointID.String())
+	resourceData.Set("service_account", []interface{}{map[string]interface{}{"ca_cert": "", "token": ""}})
+	state := resourceData.State()
+
+	diff, err := r.Diff(context.Background(), state, getServiceEndpointResourceConfig(t, r, state, config), nil)
+	require.NoError(t, err)
+	require.True(t, diff == nil || (diff.Attributes["service_account.0.token"] == nil && diff.Attributes["service_account.0.ca_cert"] == nil))
+
+	config["service_account"] = []interface{}{map[string]interface{}{"token": "UNIT_TEST_CONFIGURED_TOKEN"}}
+	diff, err = r.Diff(context.Background(), state, getServiceEndpointResourceConfig(t, r, state, config), nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff.Attributes["service_account.0.token"])
+	require.Nil(t, diff.Attributes["service_account.0.ca_cert"])
+
+	config["service_account"] = []interface{}{map[string]interface{}{}}
+	config["adopt_existing_credentials"] = false
+	diff, err = r.Diff(context.Background(), state, getServiceEndpointResourceConfig(t, r, state, config), nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff.Attributes["service_account.0.token"])
+	require.NotNil(t, diff.Attributes["service_account.0.ca_cert"])
+}
+
+func TestServiceEndpoint_IsConfiguredValueEmpty(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"token":   cty.StringVal("UNIT_TEST_TOKEN"),
+		"empty":   cty.StringVal(""),
+		"unset":   cty.NullVal(cty.String),
+		"unknown": cty.UnknownVal(cty.String),
+		"block": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"password": cty.StringVal("UNIT_TEST_PASSWORD"),
+		})}),
+		"parameters": cty.MapVal(map[string]cty.Value{
+			"password": cty.StringVal("UNIT_TEST_PASSWORD"),
+		}),
+		"no_parameters": cty.MapValEmpty(cty.String),
+	})
+
+	for k, empty := range map[string]bool{
+		"token":                 false,
+		"empty":                 true,
+		"unset":                 true,
+		"unknown":               false,
+		"missing":               true,
+		"block.0.password":      false,
+		"block.1.password":      true,
+		"parameters.%":          false,
+		"parameters.password":   false,
+		"parameters.username":   true,
+		"no_parameters.%":       true,
+		"no_parameters.missing": true,
+	} {
+		require.Equal(t, empty, isConfiguredValueEmpty(config, k), k)
+	}
+	require.True(t, isConfiguredValueEmpty(cty.NullVal(config.Type()), "token"))
+}
+
+// verifies that the status message of a failed service endpoint is surfaced
+func TestServiceEndpoint_GetServiceEndpoint_SurfacesFailedOperationStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	failedEndpoint := commonsTestServiceEndpoint
+	failedEndpoint.IsReady = converter.Bool(false)
+	failedEndpoint.OperationStatus = map[string]interface{}{
+		"state":         "Failed",
+		"statusMessage": "Insufficient privileges to complete the operation",
+	}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: commonsTestServiceEndpoint.Id,
+			Project:    converter.String(commonsTestServiceEndpointProjectID.String()),
+		}).
+		Return(&failedEndpoint, nil).
+		Times(1)
+
+	_, state, err := getServiceEndpoint(clients, commonsTestServiceEndpoint.Id, commonsTestServiceEndpointProjectID)()
+	require.Equal(t, "Failed", state)
+
+	var opErr *serviceEndpointOperationError
+	require.ErrorAs(t, err, &opErr)
+	require.Equal(t, "Failed", opErr.State)
+	require.Equal(t, "Insufficient privileges to complete the operation", opErr.StatusMessage)
+	require.Contains(t, err.Error(), "Insufficient privileges to complete the operation")
+}
+
+// verifies that a failed service endpoint which cannot be deleted is reported
+func TestServiceEndpoint_CleanupFailedServiceEndpoint_ReportsOrphanedEndpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+			EndpointId: commonsTestServiceEndpoint.Id,
+			ProjectIds: &[]string{commonsTestServiceEndpointProjectID.String()},
+		}).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	opErr := &serviceEndpointOperationError{
+		ServiceEndpointID: commonsTestServiceEndpointID.String(),
+		ProjectID:         commonsTestServiceEndpointProjectID.String(),
+		State:             "Failed",
+		StatusMessage:     "Insufficient privileges to complete the operation",
+	}
+	resourceData := schema.TestResourceDataRaw(t, baseSchema(), nil)
+	err := cleanupFailedServiceEndpoint(resourceData, clients, &commonsTestServiceEndpoint, opErr)
+
+	require.ErrorIs(t, err, opErr)
+	require.Contains(t, err.Error(), "it has to be deleted manually")
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that a service endpoint which has not failed, e.g. after a timeout, is not deleted but kept in the state
+func TestServiceEndpoint_CleanupFailedServiceEndpoint_KeepsServiceEndpointWhichHasNotFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	for _, waitErr := range []error{
+		errors.New("waiting for service endpoint ready. timeout while waiting for state to become 'Ready, Failed' (last state: 'InProgress', timeout: 2m0s)"),
+		&serviceEndpointOperationError{
+			ServiceEndpointID: commonsTestServiceEndpointID.String(),
+			ProjectID:         commonsTestServiceEndpointProjectID.String(),
+			State:             "NotReady",
+			StatusMessage:     "the service endpoint reports no operation status",
+		},
+	} {
+		resourceData := schema.TestResourceDataRaw(t, baseSchema(), nil)
+		err := cleanupFailedServiceEndpoint(resourceData, clients, &commonsTestServiceEndpoint, waitErr)
+
+		require.ErrorIs(t, err, waitErr)
+		require.Contains(t, err.Error(), "it is replaced on the next apply")
+		require.Equal(t, commonsTestServiceEndpointID.String(), resourceData.Id())
+	}
+}
+
+// getServiceEndpointResourceConfig returns the configuration of the resource and passes the raw configuration along
+// with the prior state, as Terraform does during a plan
+func getServiceEndpointResourceConfig(t *testing.T, r *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}) *terraform.ResourceConfig {
+	data, err := json.Marshal(raw)
+	require.NoError(t, err)
+	value, err := ctyjson.Unmarshal(data, r.CoreConfigSchema().ImpliedType())
+	require.NoError(t, err)
+	state.RawConfig = value
+	return terraform.NewResourceConfigShimmed(value, r.CoreConfigSchema())
+}
@@ -0,0 +1,209 @@
+package serviceendpoint
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataServiceEndpointExecutionHistory schema and implementation for the service endpoint execution history data source
+func DataServiceEndpointExecutionHistory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServiceEndpointExecutionHistoryRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"service_endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"top": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"last_used": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"executions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"definition_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"definition_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"run_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"run_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"plan_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finish_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServiceEndpointExecutionHistoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectID, err := dataSourceGetProjectID(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	serviceEndpointID, err := uuid.Parse(d.Get("service_endpoint_id").(string))
+	if err != nil {
+		return diag.Errorf("Parsing service endpoint ID: %+v", err)
+	}
+	top := d.Get("top").(int)
+
+	records, err := getServiceEndpointExecutionRecords(ctx, clients, projectID.String(), &serviceEndpointID, top)
+	if err != nil {
+		return diag.Errorf("Reading execution history of service endpoint %s in project %s: %+v", serviceEndpointID, projectID, err)
+	}
+
+	var lastUsed *time.Time
+	executions := make([]interface{}, 0, len(records))
+	for _, record := range records {
+		if record.Data == nil {
+			continue
+		}
+		executions = append(executions, flattenServiceEndpointExecution(record.Data))
+		if record.Data.StartTime != nil && (lastUsed == nil || record.Data.StartTime.Time.After(*lastUsed)) {
+			lastUsed = &record.Data.StartTime.Time
+		}
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(fmt.Sprintf("%s#%s#%d", projectID, serviceEndpointID, top))); err != nil {
+		return diag.Errorf("Unable to compute hash for service endpoint execution history: %v", err)
+	}
+	d.SetId("serviceEndpointExecutionHistory#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	if err := d.Set("executions", executions); err != nil {
+		return diag.Errorf("Error setting `executions`: %+v", err)
+	}
+	if lastUsed != nil {
+		d.Set("last_used", lastUsed.Format(time.RFC3339))
+	} else {
+		d.Set("last_used", "")
+	}
+	return nil
+}
+
+// getServiceEndpointExecutionRecords pages through the execution records of the service endpoint, newest first, until top records are read
+func getServiceEndpointExecutionRecords(ctx context.Context, clients *client.AggregatedClient, projectID string, serviceEndpointID *uuid.UUID, top int) ([]serviceendpoint.ServiceEndpointExecutionRecord, error) {
+	records := make([]serviceendpoint.ServiceEndpointExecutionRecord, 0)
+	var continuationToken *uint64
+	for len(records) < top {
+		resp, err := clients.ServiceEndpointClient.GetServiceEndpointExecutionRecords(ctx, serviceendpoint.GetServiceEndpointExecutionRecordsArgs{
+			Project:           converter.String(projectID),
+			EndpointId:        serviceEndpointID,
+			Top:               converter.Int(top - len(records)),
+			ContinuationToken: continuationToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil {
+			break
+		}
+		records = append(records, resp.Value...)
+
+		if resp.ContinuationToken == "" || len(resp.Value) == 0 {
+			break
+		}
+		token, err := strconv.ParseUint(resp.ContinuationToken, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Parsing continuation token %q: %+v", resp.ContinuationToken, err)
+		}
+		continuationToken = &token
+	}
+	if len(records) > top {
+		records = records[:top]
+	}
+	return records, nil
+}
+
+func flattenServiceEndpointExecution(data *serviceendpoint.ServiceEndpointExecutionData) map[string]interface{} {
+	execution := map[string]interface{}{
+		"id":              0,
+		"definition_id":   0,
+		"definition_name": "",
+		"run_id":          0,
+		"run_name":        "",
+		"plan_type":       converter.ToString(data.PlanType, ""),
+		"result":          "",
+		"start_time":      "",
+		"finish_time":     "",
+	}
+	if data.Id != nil {
+		execution["id"] = int(*data.Id)
+	}
+	if data.Definition != nil {
+		execution["definition_id"] = converter.ToInt(data.Definition.Id, 0)
+		execution["definition_name"] = converter.ToString(data.Definition.Name, "")
+	}
+	if data.Owner != nil {
+		execution["run_id"] = converter.ToInt(data.Owner.Id, 0)
+		execution["run_name"] = converter.ToString(data.Owner.Name, "")
+	}
+	if data.Result != nil {
+		execution["result"] = string(*data.Result)
+	}
+	if data.StartTime != nil {
+		execution["start_time"] = data.StartTime.Time.Format(time.RFC3339)
+	}
+	if data.FinishTime != nil {
+		execution["finish_time"] = data.FinishTime.Time.Format(time.RFC3339)
+	}
+	return execution
+}
@@ -0,0 +1,146 @@
+//go:build (all || data_serviceendpoint_execution_history) && !exclude_serviceendpoints
+// +build all data_serviceendpoint_execution_history
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newServiceEndpointExecutionRecord(id uint64, runID int, result serviceendpoint.ServiceEndpointExecutionResult, startTime time.Time) serviceendpoint.ServiceEndpointExecutionRecord {
+	return serviceendpoint.ServiceEndpointExecutionRecord{
+		Data: &serviceendpoint.ServiceEndpointExecutionData{
+			Id:         &id,
+			Definition: &serviceendpoint.ServiceEndpointExecutionOwner{Id: converter.Int(7), Name: converter.String("deploy")},
+			Owner:      &serviceendpoint.ServiceEndpointExecutionOwner{Id: converter.Int(runID), Name: converter.String("20240101.1")},
+			PlanType:   converter.String("Build"),
+			Result:     &result,
+			StartTime:  &azuredevops.Time{Time: startTime},
+			FinishTime: &azuredevops.Time{Time: startTime.Add(time.Minute)},
+		},
+	}
+}
+
+// verifies that the execution records are paged until top records are read and the last use is reported
+func TestDataSourceServiceEndpointExecutionHistory_Read_PagesRecords(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectID := uuid.New()
+	endpointID := uuid.New()
+	newest := time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC)
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointExecutionRecords(clients.Ctx, serviceendpoint.GetServiceEndpointExecutionRecordsArgs{
+			Project:    converter.String(projectID.String()),
+			EndpointId: &endpointID,
+			Top:        converter.Int(3),
+		}).
+		Return(&serviceendpoint.GetServiceEndpointExecutionRecordsResponseValue{
+			Value: []serviceendpoint.ServiceEndpointExecutionRecord{
+				newServiceEndpointExecutionRecord(3, 30, serviceendpoint.ServiceEndpointExecutionResultValues.Succeeded, newest),
+				newServiceEndpointExecutionRecord(2, 20, serviceendpoint.ServiceEndpointExecutionResultValues.Failed, newest.Add(-time.Hour)),
+			},
+			ContinuationToken: "2",
+		}, nil).
+		Times(1)
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointExecutionRecords(clients.Ctx, serviceendpoint.GetServiceEndpointExecutionRecordsArgs{
+			Project:           converter.String(projectID.String()),
+			EndpointId:        &endpointID,
+			Top:               converter.Int(1),
+			ContinuationToken: converter.UInt64(2),
+		}).
+		Return(&serviceendpoint.GetServiceEndpointExecutionRecordsResponseValue{
+			Value: []serviceendpoint.ServiceEndpointExecutionRecord{
+				newServiceEndpointExecutionRecord(1, 10, serviceendpoint.ServiceEndpointExecutionResultValues.Succeeded, newest.Add(-2*time.Hour)),
+			},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServiceEndpointExecutionHistory().Schema, nil)
+	resourceData.Set("project_id", projectID.String())
+	resourceData.Set("service_endpoint_id", endpointID.String())
+	resourceData.Set("top", 3)
+
+	diags := dataSourceServiceEndpointExecutionHistoryRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "2024-05-02T10:00:00Z", resourceData.Get("last_used"))
+
+	executions := resourceData.Get("executions").([]interface{})
+	require.Len(t, executions, 3)
+	first := executions[0].(map[string]interface{})
+	require.Equal(t, 3, first["id"])
+	require.Equal(t, 7, first["definition_id"])
+	require.Equal(t, "deploy", first["definition_name"])
+	require.Equal(t, 30, first["run_id"])
+	require.Equal(t, "succeeded", first["result"])
+	require.Equal(t, "2024-05-02T10:01:00Z", first["finish_time"])
+	require.Equal(t, "failed", executions[1].(map[string]interface{})["result"])
+}
+
+// verifies that an endpoint without executions has no last use
+func TestDataSourceServiceEndpointExecutionHistory_Read_NoExecutions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointExecutionRecords(clients.Ctx, gomock.Any()).
+		Return(&serviceendpoint.GetServiceEndpointExecutionRecordsResponseValue{}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServiceEndpointExecutionHistory().Schema, nil)
+	resourceData.Set("project_id", uuid.New().String())
+	resourceData.Set("service_endpoint_id", uuid.New().String())
+
+	diags := dataSourceServiceEndpointExecutionHistoryRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "", resourceData.Get("last_used"))
+	require.Len(t, resourceData.Get("executions").([]interface{}), 0)
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestDataSourceServiceEndpointExecutionHistory_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointExecutionRecords(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetServiceEndpointExecutionRecords() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServiceEndpointExecutionHistory().Schema, nil)
+	resourceData.Set("project_id", uuid.New().String())
+	resourceData.Set("service_endpoint_id", uuid.New().String())
+
+	diags := dataSourceServiceEndpointExecutionHistoryRead(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "GetServiceEndpointExecutionRecords() Failed")
+}
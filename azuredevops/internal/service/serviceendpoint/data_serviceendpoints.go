@@ -0,0 +1,183 @@
+package serviceendpoint
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataServiceEndpoints schema and implementation for the service endpoints data source
+func DataServiceEndpoints() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServiceEndpointsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"library", "agentcloud"}, false),
+			},
+			"authorization_scheme": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"ready_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"include_failed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"service_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authorization_scheme": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_ready": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_shared": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"shared_project_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServiceEndpointsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectID, err := dataSourceGetProjectID(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	endpointType := d.Get("type").(string)
+	owner := d.Get("owner").(string)
+	authScheme := d.Get("authorization_scheme").(string)
+	readyOnly := d.Get("ready_only").(bool)
+	includeFailed := d.Get("include_failed").(bool)
+
+	serviceEndpoints, err := dataSourceGetServiceEndpoints(clients, projectID, endpointType, owner, authScheme, includeFailed)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	results := make([]interface{}, 0, len(*serviceEndpoints))
+	ids := make([]string, 0, len(*serviceEndpoints))
+	for _, serviceEndpoint := range *serviceEndpoints {
+		if serviceEndpoint.Id == nil {
+			continue
+		}
+		if readyOnly && (serviceEndpoint.IsReady == nil || !*serviceEndpoint.IsReady) {
+			continue
+		}
+		results = append(results, flattenServiceEndpointListItem(&serviceEndpoint, projectID.String()))
+		ids = append(ids, serviceEndpoint.Id.String())
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(fmt.Sprintf("%s#%s#%s#%s#%t#%t#%s", projectID, endpointType, owner, authScheme, readyOnly, includeFailed, strings.Join(ids, "-")))); err != nil {
+		return diag.Errorf("Unable to compute hash for service endpoints: %v", err)
+	}
+	d.SetId("serviceEndpoints#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	if err := d.Set("service_endpoints", results); err != nil {
+		return diag.Errorf("Error setting `service_endpoints`: %+v", err)
+	}
+	return nil
+}
+
+func flattenServiceEndpointListItem(serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID string) map[string]interface{} {
+	item := map[string]interface{}{
+		"id":                   serviceEndpoint.Id.String(),
+		"name":                 converter.ToString(serviceEndpoint.Name, ""),
+		"type":                 converter.ToString(serviceEndpoint.Type, ""),
+		"description":          converter.ToString(serviceEndpoint.Description, ""),
+		"owner":                converter.ToString(serviceEndpoint.Owner, ""),
+		"url":                  converter.ToString(serviceEndpoint.Url, ""),
+		"authorization_scheme": "",
+		"is_ready":             converter.ToBool(serviceEndpoint.IsReady, false),
+		"is_shared":            converter.ToBool(serviceEndpoint.IsShared, false),
+	}
+	if serviceEndpoint.Authorization != nil {
+		item["authorization_scheme"] = converter.ToString(serviceEndpoint.Authorization.Scheme, "")
+	}
+
+	sharedProjectIDs := []interface{}{}
+	if serviceEndpoint.ServiceEndpointProjectReferences != nil {
+		for _, reference := range *serviceEndpoint.ServiceEndpointProjectReferences {
+			if reference.ProjectReference == nil || reference.ProjectReference.Id == nil ||
+				strings.EqualFold(reference.ProjectReference.Id.String(), projectID) {
+				continue
+			}
+			sharedProjectIDs = append(sharedProjectIDs, reference.ProjectReference.Id.String())
+		}
+	}
+	item["shared_project_ids"] = sharedProjectIDs
+	return item
+}
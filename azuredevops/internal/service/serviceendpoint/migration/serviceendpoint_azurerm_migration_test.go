@@ -0,0 +1,79 @@
+//go:build (all || resource_serviceendpoint_azurerm) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_azurerm
+// +build !exclude_serviceendpoints
+
+package migration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceEndpointAzureRmStateUpgradeV0ToV1_SetsDefaultEnvironment(t *testing.T) {
+	state, err := ServiceEndpointAzureRmStateUpgradeV0ToV1()(context.Background(), map[string]interface{}{
+		"service_endpoint_name": "example",
+	}, nil)
+	require.Nil(t, err)
+	require.Equal(t, "AzureCloud", state["environment"])
+
+	state, err = ServiceEndpointAzureRmStateUpgradeV0ToV1()(context.Background(), map[string]interface{}{
+		"environment": "AzureChinaCloud",
+	}, nil)
+	require.Nil(t, err)
+	require.Equal(t, "AzureChinaCloud", state["environment"])
+}
+
+// The in-place conversion of the authentication scheme compares the old and the new scheme, states written before
+// the scheme was introduced must upgrade to the scheme of the existing service endpoints.
+func TestServiceEndpointAzureRmStateUpgradeV1ToV2_SetsDefaultAuthenticationScheme(t *testing.T) {
+	state, err := ServiceEndpointAzureRmStateUpgradeV1ToV2()(context.Background(), map[string]interface{}{
+		"service_endpoint_name": "example",
+	}, nil)
+	require.Nil(t, err)
+	require.Equal(t, "ServicePrincipal", state["service_endpoint_authentication_scheme"])
+
+	state, err = ServiceEndpointAzureRmStateUpgradeV1ToV2()(context.Background(), map[string]interface{}{
+		"service_endpoint_authentication_scheme": "WorkloadIdentityFederation",
+	}, nil)
+	require.Nil(t, err)
+	require.Equal(t, "WorkloadIdentityFederation", state["service_endpoint_authentication_scheme"])
+}
+
+// The revert deadline decides whether a change of the authentication scheme is applied in place, states written
+// before the conversion was supported have no deadline.
+func TestServiceEndpointAzureRmStateUpgradeV2ToV3_SetsEmptyRevertSchemeDeadline(t *testing.T) {
+	state, err := ServiceEndpointAzureRmStateUpgradeV2ToV3()(context.Background(), map[string]interface{}{
+		"service_endpoint_authentication_scheme": "ServicePrincipal",
+	}, nil)
+	require.Nil(t, err)
+	require.Equal(t, "", state["revert_scheme_deadline"])
+	require.Equal(t, "ServicePrincipal", state["service_endpoint_authentication_scheme"])
+
+	state, err = ServiceEndpointAzureRmStateUpgradeV2ToV3()(context.Background(), map[string]interface{}{
+		"revert_scheme_deadline": "2024-06-01T00:00:00Z",
+	}, nil)
+	require.Nil(t, err)
+	require.Equal(t, "2024-06-01T00:00:00Z", state["revert_scheme_deadline"])
+}
+
+func TestServiceEndpointAzureRmStateUpgrade_V0ToV3(t *testing.T) {
+	state, err := ServiceEndpointAzureRmStateUpgradeV0ToV1()(context.Background(), map[string]interface{}{
+		"project_id":            "00000000-0000-0000-0000-000000000000",
+		"service_endpoint_name": "example",
+	}, nil)
+	require.Nil(t, err)
+	state, err = ServiceEndpointAzureRmStateUpgradeV1ToV2()(context.Background(), state, nil)
+	require.Nil(t, err)
+	state, err = ServiceEndpointAzureRmStateUpgradeV2ToV3()(context.Background(), state, nil)
+	require.Nil(t, err)
+
+	require.Equal(t, map[string]interface{}{
+		"project_id":                             "00000000-0000-0000-0000-000000000000",
+		"service_endpoint_name":                  "example",
+		"environment":                            "AzureCloud",
+		"service_endpoint_authentication_scheme": "ServicePrincipal",
+		"revert_scheme_deadline":                 "",
+	}, state)
+}
@@ -0,0 +1,150 @@
+package migration
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// https://developer.hashicorp.com/terraform/plugin/sdkv2/resources/state-migration
+func ServiceEndpointAzureRmSchemaV2ToV3() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_endpoint_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"authorization": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"azurerm_spn_tenantid": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"azurerm_subscription_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"azurerm_subscription_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"azurerm_management_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"azurerm_management_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"credentials": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"serviceprincipalid": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"serviceprincipalkey": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"serviceprincipalcertificate": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
+			"environment": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"service_endpoint_authentication_scheme": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"server_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"workload_identity_federation_issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"workload_identity_federation_subject": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"service_principal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"features": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"validate": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ServiceEndpointAzureRmStateUpgradeV2ToV3 adds the revert deadline of the authentication scheme conversion. The
+// authentication scheme is no longer ForceNew, a service endpoint which has not been converted has no deadline.
+func ServiceEndpointAzureRmStateUpgradeV2ToV3() schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if _, ok := rawState["revert_scheme_deadline"]; !ok {
+			rawState["revert_scheme_deadline"] = ""
+		}
+
+		return rawState, nil
+	}
+}
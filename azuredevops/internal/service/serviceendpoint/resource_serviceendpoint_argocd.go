@@ -12,7 +12,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/validate"
 )
 
@@ -29,7 +28,7 @@ func ResourceServiceEndpointArgoCD() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 
@@ -49,10 +48,11 @@ func ResourceServiceEndpointArgoCD() *schema.Resource {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"token": {
-						Description: "The ArgoCD access token.",
-						Type:        schema.TypeString,
-						Required:    true,
-						Sensitive:   true,
+						Description:      "The ArgoCD access token.",
+						Type:             schema.TypeString,
+						Required:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
 					},
 				},
 			},
@@ -73,15 +73,17 @@ func ResourceServiceEndpointArgoCD() *schema.Resource {
 						Sensitive:   true,
 					},
 					"password": {
-						Description: "The ArgoCD password.",
-						Type:        schema.TypeString,
-						Required:    true,
-						Sensitive:   true,
+						Description:      "The ArgoCD password.",
+						Type:             schema.TypeString,
+						Required:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
 					},
 				},
 			},
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
 
 	return r
 }
@@ -135,10 +137,15 @@ func resourceServiceEndpointArgoCDUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	_, err = updateServiceEndpoint(clients, serviceEndpoint)
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	_, err = updateServiceEndpoint(d, clients, serviceEndpoint)
 	if err != nil {
 		return fmt.Errorf("updating service endpoint in Azure DevOps: %+v", err)
 	}
+
 	return resourceServiceEndpointArgoCDRead(d, m)
 }
 
@@ -12,7 +12,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/validate"
 )
 
@@ -29,7 +28,7 @@ func ResourceServiceEndpointArtifactory() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 
@@ -49,10 +48,11 @@ func ResourceServiceEndpointArtifactory() *schema.Resource {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"token": {
-						Description: "The Artifactory access token.",
-						Type:        schema.TypeString,
-						Required:    true,
-						Sensitive:   true,
+						Description:      "The Artifactory access token.",
+						Type:             schema.TypeString,
+						Required:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
 					},
 				},
 			},
@@ -73,15 +73,17 @@ func ResourceServiceEndpointArtifactory() *schema.Resource {
 						Sensitive:   true,
 					},
 					"password": {
-						Description: "The Artifactory password.",
-						Type:        schema.TypeString,
-						Required:    true,
-						Sensitive:   true,
+						Description:      "The Artifactory password.",
+						Type:             schema.TypeString,
+						Required:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
 					},
 				},
 			},
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
 
 	return r
 }
@@ -136,7 +138,11 @@ func resourceServiceEndpointArtifactoryUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	_, err = updateServiceEndpoint(clients, serviceEndpoint)
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	_, err = updateServiceEndpoint(d, clients, serviceEndpoint)
 	if err != nil {
 		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
 	}
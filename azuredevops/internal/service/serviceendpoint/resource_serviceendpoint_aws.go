@@ -11,7 +11,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 // ResourceServiceEndpointAws schema and implementation for aws service endpoint resource
@@ -27,7 +26,7 @@ func ResourceServiceEndpointAws() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 
@@ -41,20 +40,22 @@ func ResourceServiceEndpointAws() *schema.Resource {
 		},
 
 		"secret_access_key": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			DefaultFunc:  schema.EnvDefaultFunc("AZDO_AWS_SERVICE_CONNECTION_SECRET_ACCESS_KEY", nil),
-			Description:  "The AWS secret access key for signing programmatic requests.",
-			Sensitive:    true,
-			RequiredWith: []string{"access_key_id"},
+			Type:             schema.TypeString,
+			Optional:         true,
+			DefaultFunc:      schema.EnvDefaultFunc("AZDO_AWS_SERVICE_CONNECTION_SECRET_ACCESS_KEY", nil),
+			Description:      "The AWS secret access key for signing programmatic requests.",
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			RequiredWith:     []string{"access_key_id"},
 		},
 
 		"session_token": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			DefaultFunc: schema.EnvDefaultFunc("AZDO_AWS_SERVICE_CONNECTION_SESSION_TOKEN", nil),
-			Description: "The AWS session token for signing programmatic requests.",
-			Sensitive:   true,
+			Type:             schema.TypeString,
+			Optional:         true,
+			DefaultFunc:      schema.EnvDefaultFunc("AZDO_AWS_SERVICE_CONNECTION_SESSION_TOKEN", nil),
+			Description:      "The AWS session token for signing programmatic requests.",
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
 		},
 		"role_to_assume": {
 			Type:        schema.TypeString,
@@ -84,6 +85,7 @@ func ResourceServiceEndpointAws() *schema.Resource {
 			Description: "Enable this to attempt getting credentials with OIDC token from Azure Devops.",
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
 
 	return r
 }
@@ -131,9 +133,14 @@ func resourceServiceEndpointAwsUpdate(d *schema.ResourceData, m interface{}) err
 	clients := m.(*client.AggregatedClient)
 	serviceEndpoint := expandServiceEndpointAws(d)
 
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
 	}
+
 	return resourceServiceEndpointAwsRead(d, m)
 }
 
@@ -166,6 +166,12 @@ func TestServiceEndpointAws_Update_DoesNotSwallowError(t *testing.T) {
 		EndpointId: awsTestServiceEndpoint.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(expectedArgs.Endpoint, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
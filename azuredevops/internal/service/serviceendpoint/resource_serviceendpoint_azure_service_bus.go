@@ -12,7 +12,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 func ResourceServiceEndpointAzureServiceBus() *schema.Resource {
@@ -27,16 +26,17 @@ func ResourceServiceEndpointAzureServiceBus() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 
 	maps.Copy(r.Schema, map[string]*schema.Schema{
 		"connection_string": {
-			Type:         schema.TypeString,
-			Required:     true,
-			Sensitive:    true,
-			ValidateFunc: validation.StringIsNotEmpty,
+			Type:             schema.TypeString,
+			Required:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			ValidateFunc:     validation.StringIsNotEmpty,
 		},
 
 		"queue_name": {
@@ -45,6 +45,8 @@ func ResourceServiceEndpointAzureServiceBus() *schema.Resource {
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
+
 	return r
 }
 
@@ -86,7 +88,11 @@ func resourceServiceEndpointAzureServiceBusRead(ctx context.Context, d *schema.R
 func resourceServiceEndpointAzureServiceBusUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clients := m.(*client.AggregatedClient)
 	serviceEndpoint := expandServiceEndpointAzureServiceBus(d)
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return diag.Errorf(" Updating service endpoint in Azure DevOps: %+v", err)
 	}
 
@@ -11,7 +11,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 func ResourceServiceEndpointAzureDevOps() *schema.Resource {
@@ -26,7 +25,7 @@ func ResourceServiceEndpointAzureDevOps() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 	r.DeprecationMessage = "This resource is duplicate with azuredevops_serviceendpoint_runpipeline,  will be removed in the future, use azuredevops_serviceendpoint_runpipeline instead."
@@ -48,13 +47,15 @@ func ResourceServiceEndpointAzureDevOps() *schema.Resource {
 		},
 
 		"personal_access_token": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Sensitive:   true,
-			DefaultFunc: schema.EnvDefaultFunc("AZDO_DEVOPS_PAT", nil),
-			Description: "The Azure DevOps personal access token.",
+			Type:             schema.TypeString,
+			Required:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			DefaultFunc:      schema.EnvDefaultFunc("AZDO_DEVOPS_PAT", nil),
+			Description:      "The Azure DevOps personal access token.",
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
 
 	return r
 }
@@ -100,7 +101,11 @@ func resourceServiceEndpointAzureDevOpsRead(d *schema.ResourceData, m interface{
 func resourceServiceEndpointAzureDevOpsUpdate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	serviceEndpoint := expandServiceEndpointAzureDevOps(d)
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
 	}
 
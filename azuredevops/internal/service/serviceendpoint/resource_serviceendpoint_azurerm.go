@@ -1,11 +1,13 @@
 package serviceendpoint
 
 import (
+	"context"
 	"fmt"
 	"maps"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
@@ -13,11 +15,13 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/serviceendpoint/migration"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 const endpointValidationTimeoutSeconds = 60 * time.Second
 
+// timeNow returns the current time, it is replaced in tests
+var timeNow = time.Now
+
 // ResourceServiceEndpointAzureRM schema and implementation for AzureRM service endpoint resource
 func ResourceServiceEndpointAzureRM() *schema.Resource {
 	r := &schema.Resource{
@@ -31,8 +35,9 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
-		Schema:   baseSchema(),
+		Importer:      importServiceEndpoint(),
+		CustomizeDiff: customizeServiceEndpointAzureRMDiff,
+		Schema:        baseSchema(),
 	}
 
 	maps.Copy(r.Schema, map[string]*schema.Schema{
@@ -97,18 +102,20 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 						Description: "The service principal id which should be used.",
 					},
 					"serviceprincipalkey": {
-						Type:          schema.TypeString,
-						Optional:      true,
-						ConflictsWith: []string{"credentials.0.serviceprincipalcertificate"},
-						Sensitive:     true,
-						ValidateFunc:  validation.StringIsNotEmpty,
+						Type:             schema.TypeString,
+						Optional:         true,
+						ConflictsWith:    []string{"credentials.0.serviceprincipalcertificate"},
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
+						ValidateFunc:     validation.StringIsNotEmpty,
 					},
 					"serviceprincipalcertificate": {
-						Type:          schema.TypeString,
-						Optional:      true,
-						ConflictsWith: []string{"credentials.0.serviceprincipalkey"},
-						Sensitive:     true,
-						ValidateFunc:  validation.StringIsNotEmpty,
+						Type:             schema.TypeString,
+						Optional:         true,
+						ConflictsWith:    []string{"credentials.0.serviceprincipalkey"},
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
+						ValidateFunc:     validation.StringIsNotEmpty,
 					},
 				},
 			},
@@ -126,7 +133,6 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 		"service_endpoint_authentication_scheme": {
 			Type:         schema.TypeString,
 			Optional:     true,
-			ForceNew:     true,
 			Description:  "The AzureRM Service Endpoint Authentication Scheme, this can be 'WorkloadIdentityFederation', 'ManagedServiceIdentity' or 'ServicePrincipal'.",
 			Default:      "ServicePrincipal",
 			ValidateFunc: validation.StringInSlice([]string{"WorkloadIdentityFederation", "ManagedServiceIdentity", "ServicePrincipal"}, false),
@@ -157,6 +163,12 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 			Computed: true,
 		},
 
+		"revert_scheme_deadline": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The deadline until a conversion to workload identity federation can be reverted.",
+		},
+
 		"features": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -173,8 +185,9 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 			},
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
 
-	r.SchemaVersion = 2
+	r.SchemaVersion = 3
 	r.StateUpgraders = []schema.StateUpgrader{
 		{
 			Type:    migration.ServiceEndpointAzureRmSchemaV0ToV1().CoreConfigSchema().ImpliedType(),
@@ -186,6 +199,11 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 			Upgrade: migration.ServiceEndpointAzureRmStateUpgradeV1ToV2(),
 			Version: 1,
 		},
+		{
+			Type:    migration.ServiceEndpointAzureRmSchemaV2ToV3().CoreConfigSchema().ImpliedType(),
+			Upgrade: migration.ServiceEndpointAzureRmStateUpgradeV2ToV3(),
+			Version: 2,
+		},
 	}
 
 	return r
@@ -260,12 +278,32 @@ func resourceServiceEndpointAzureRMUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
+	if oldScheme, newScheme := d.GetChange("service_endpoint_authentication_scheme"); oldScheme.(string) != "" && oldScheme != newScheme {
+		if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+			return err
+		}
+
+		if err := convertServiceEndpointAzureRM(d, clients, serviceEndpoint); err != nil {
+			return err
+		}
+		if shouldValidate(endpointFeatures(d)) {
+			if err := validateServiceEndpoint(clients, serviceEndpoint, d.Get("project_id").(string), endpointValidationTimeoutSeconds); err != nil {
+				return err
+			}
+		}
+		return resourceServiceEndpointAzureRMRead(d, m)
+	}
+
 	if shouldValidate(endpointFeatures(d)) {
 		if err := validateServiceEndpoint(clients, serviceEndpoint, d.Get("project_id").(string), endpointValidationTimeoutSeconds); err != nil {
 			return err
 		}
 	}
-	_, err = updateServiceEndpoint(clients, serviceEndpoint)
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	_, err = updateServiceEndpoint(d, clients, serviceEndpoint)
 	if err != nil {
 		return fmt.Errorf("updating service endpoint in Azure DevOps: %+v", err)
 	}
@@ -474,6 +512,8 @@ func flattenServiceEndpointAzureRM(d *schema.ResourceData, serviceEndpoint *serv
 		d.Set("workload_identity_federation_subject", (*serviceEndpoint.Authorization.Parameters)["workloadIdentityFederationSubject"])
 	}
 
+	d.Set("revert_scheme_deadline", (*serviceEndpoint.Data)["revertSchemeDeadline"])
+
 	if (*serviceEndpoint.Data)["creationMode"] == "Manual" {
 		if _, ok := d.GetOk("credentials"); !ok {
 			credentials := make(map[string]interface{})
@@ -510,6 +550,85 @@ func flattenServiceEndpointAzureRM(d *schema.ResourceData, serviceEndpoint *serv
 	}
 }
 
+// convertServiceEndpointAzureRM converts the authentication scheme of the service endpoint in place, so the ID of
+// the service endpoint and the pipelines referencing it are not affected
+func convertServiceEndpointAzureRM(d *schema.ResourceData, clients *client.AggregatedClient, serviceEndpoint *serviceendpoint.ServiceEndpoint) error {
+	// Service endpoints created in automatic mode keep their app registration, the service principal ID is only
+	// known from the state.
+	if servicePrincipalID := d.Get("service_principal_id").(string); servicePrincipalID != "" &&
+		(*serviceEndpoint.Authorization.Parameters)["serviceprincipalid"] == "" {
+		(*serviceEndpoint.Authorization.Parameters)["serviceprincipalid"] = servicePrincipalID
+	}
+
+	convertedServiceEndpoint, err := updateServiceEndpointWithOperation(d, clients, serviceEndpoint, "ConvertAuthenticationScheme")
+	if err != nil {
+		oldScheme, newScheme := d.GetChange("service_endpoint_authentication_scheme")
+		return fmt.Errorf("converting service endpoint authentication scheme from %s to %s: %+v", oldScheme, newScheme, err)
+	}
+	if convertedServiceEndpoint != nil && convertedServiceEndpoint.IsReady != nil && *convertedServiceEndpoint.IsReady {
+		return nil
+	}
+
+	projectID := (*serviceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id
+	stateConf := &retry.StateChangeConf{
+		ContinuousTargetOccurence: 1,
+		Delay:                     10 * time.Second,
+		MinTimeout:                10 * time.Second,
+		Pending:                   []string{opState.InProgress},
+		Target:                    []string{opState.Ready},
+		Refresh:                   getServiceEndpoint(clients, serviceEndpoint.Id, projectID),
+		Timeout:                   d.Timeout(schema.TimeoutUpdate),
+	}
+	if _, err := stateConf.WaitForStateContext(clients.Ctx); err != nil {
+		return fmt.Errorf("waiting for service endpoint authentication scheme conversion. %v ", err)
+	}
+	return nil
+}
+
+// customizeServiceEndpointAzureRMDiff replaces the service endpoint if the authentication scheme change cannot be
+// applied in place. Service principal endpoints can be converted to workload identity federation, and converted
+// endpoints can be reverted until the revert deadline has passed.
+func customizeServiceEndpointAzureRMDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("service_endpoint_authentication_scheme") {
+		return nil
+	}
+
+	oldScheme, newScheme := d.GetChange("service_endpoint_authentication_scheme")
+	convertible, err := canConvertAuthenticationScheme(EndpointAuthenticationScheme(oldScheme.(string)), EndpointAuthenticationScheme(newScheme.(string)), d.Get("revert_scheme_deadline").(string))
+	if err != nil {
+		return err
+	}
+	if !convertible {
+		return d.ForceNew("service_endpoint_authentication_scheme")
+	}
+
+	for _, key := range []string{"workload_identity_federation_issuer", "workload_identity_federation_subject", "revert_scheme_deadline"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// canConvertAuthenticationScheme returns whether the authentication scheme can be changed in place. A service
+// endpoint without a revert deadline has not been converted and cannot be reverted.
+func canConvertAuthenticationScheme(oldScheme EndpointAuthenticationScheme, newScheme EndpointAuthenticationScheme, revertDeadline string) (bool, error) {
+	switch {
+	case oldScheme == ServicePrincipal && newScheme == WorkloadIdentityFederation:
+		return true, nil
+	case oldScheme == WorkloadIdentityFederation && newScheme == ServicePrincipal:
+		if revertDeadline == "" {
+			return false, nil
+		}
+		deadline, err := time.Parse(time.RFC3339, revertDeadline)
+		if err != nil {
+			return false, fmt.Errorf("parsing revert_scheme_deadline %q of the service endpoint: %v", revertDeadline, err)
+		}
+		return timeNow().Before(deadline), nil
+	}
+	return false, nil
+}
+
 // Validation function to ensure either Subscription or ManagementGroup scopeLevels are set correctly
 func validateScopeLevel(scopeMap map[string][]string) error {
 	// Check for empty
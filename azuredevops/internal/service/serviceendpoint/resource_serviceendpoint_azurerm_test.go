@@ -8,9 +8,11 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
@@ -454,6 +456,12 @@ func TestServiceEndpointAzureRM_Update_DoesNotSwallowError(t *testing.T) {
 			EndpointId: resource.Id,
 		}
 
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+			Return(expectedArgs.Endpoint, nil).
+			Times(1)
+
 		buildClient.
 			EXPECT().
 			UpdateServiceEndpoint(clients.Ctx, expectedArgs).
@@ -503,6 +511,377 @@ func TestServiceEndpointAzureRM_UpdateWithValidate_DoesNotSwallowError(t *testin
 	}
 }
 
+func TestServiceEndpointAzureRM_CanConvertAuthenticationScheme(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	originalTimeNow := timeNow
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = originalTimeNow }()
+
+	for _, tc := range []struct {
+		oldScheme   EndpointAuthenticationScheme
+		newScheme   EndpointAuthenticationScheme
+		deadline    string
+		convertible bool
+	}{
+		{ServicePrincipal, WorkloadIdentityFederation, "", true},
+		{WorkloadIdentityFederation, ServicePrincipal, now.Add(time.Hour).Format(time.RFC3339), true},
+		{WorkloadIdentityFederation, ServicePrincipal, now.Add(-time.Hour).Format(time.RFC3339), false},
+		{WorkloadIdentityFederation, ServicePrincipal, "", false},
+		{ServicePrincipal, ManagedServiceIdentity, "", false},
+		{ManagedServiceIdentity, WorkloadIdentityFederation, "", false},
+	} {
+		convertible, err := canConvertAuthenticationScheme(tc.oldScheme, tc.newScheme, tc.deadline)
+		require.Nil(t, err)
+		require.Equal(t, tc.convertible, convertible, "%s to %s with deadline %q", tc.oldScheme, tc.newScheme, tc.deadline)
+	}
+
+	_, err := canConvertAuthenticationScheme(WorkloadIdentityFederation, ServicePrincipal, "next week")
+	require.Contains(t, err.Error(), "parsing revert_scheme_deadline")
+}
+
+// verifies that a revert deadline which cannot be parsed fails the plan instead of replacing the service endpoint
+func TestServiceEndpointAzureRM_Diff_DoesNotReplaceOnUnparsableRevertDeadline(t *testing.T) {
+	r := ResourceServiceEndpointAzureRM()
+	endpoint := azurermTestServiceEndpointsAzureRM[1]
+	resourceData := getResourceData(t, endpoint)
+	resourceData.Set("project_id", azurermTestServiceEndpointAzureRMProjectID.String())
+	flattenServiceEndpointAzureRM(resourceData, &endpoint)
+	resourceData.Set("service_endpoint_authentication_scheme", string(WorkloadIdentityFederation))
+	resourceData.Set("revert_scheme_deadline", "next week")
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id":                             azurermTestServiceEndpointAzureRMProjectID.String(),
+		"service_endpoint_name":                  *endpoint.Name,
+		"description":                            *endpoint.Description,
+		"azurerm_spn_tenantid":                   (*endpoint.Authorization.Parameters)["tenantid"],
+		"azurerm_subscription_id":                (*endpoint.Data)["subscriptionId"],
+		"azurerm_subscription_name":              (*endpoint.Data)["subscriptionName"],
+		"service_endpoint_authentication_scheme": string(ServicePrincipal),
+	})
+	_, err := r.Diff(context.Background(), resourceData.State(), config, nil)
+	require.Contains(t, err.Error(), "parsing revert_scheme_deadline")
+}
+
+func TestServiceEndpointAzureRM_Update_ConvertsAuthenticationSchemeInPlace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointAzureRM()
+	endpoint := azurermTestServiceEndpointsAzureRM[1]
+	servicePrincipalID := uuid.New().String()
+	(*endpoint.Authorization.Parameters)["serviceprincipalid"] = servicePrincipalID
+	defer func() { (*endpoint.Authorization.Parameters)["serviceprincipalid"] = "" }()
+
+	resourceData := getResourceData(t, endpoint)
+	resourceData.Set("project_id", azurermTestServiceEndpointAzureRMProjectID.String())
+	flattenServiceEndpointAzureRM(resourceData, &endpoint)
+	state := resourceData.State()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id":                             azurermTestServiceEndpointAzureRMProjectID.String(),
+		"service_endpoint_name":                  *endpoint.Name,
+		"description":                            *endpoint.Description,
+		"azurerm_spn_tenantid":                   (*endpoint.Authorization.Parameters)["tenantid"],
+		"azurerm_subscription_id":                (*endpoint.Data)["subscriptionId"],
+		"azurerm_subscription_name":              (*endpoint.Data)["subscriptionName"],
+		"service_endpoint_authentication_scheme": string(WorkloadIdentityFederation),
+	})
+	diff, err := r.Diff(context.Background(), state, config, nil)
+	require.Nil(t, err)
+	require.False(t, diff.RequiresNew())
+
+	resourceData, err = schema.InternalMap(r.Schema).Data(state, diff)
+	require.Nil(t, err)
+
+	endpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: endpointClient, Ctx: context.Background()}
+
+	converted := getManualAuthServiceEndpoint()
+	converted.IsReady = converter.Bool(true)
+	converted.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"serviceprincipalid":                servicePrincipalID,
+			"tenantid":                          (*endpoint.Authorization.Parameters)["tenantid"],
+			"workloadIdentityFederationIssuer":  "https://vstoken.dev.azure.com/00000000-0000-0000-0000-000000000000",
+			"workloadIdentityFederationSubject": "sc://org/project/name",
+		},
+		Scheme: converter.String(string(WorkloadIdentityFederation)),
+	}
+	converted.Data = &map[string]string{
+		"creationMode":         "Automatic",
+		"environment":          "AzureCloud",
+		"scopeLevel":           "Subscription",
+		"subscriptionId":       (*endpoint.Data)["subscriptionId"],
+		"subscriptionName":     (*endpoint.Data)["subscriptionName"],
+		"revertSchemeDeadline": "2024-06-08T00:00:00Z",
+	}
+
+	endpointClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args serviceendpoint.UpdateServiceEndpointArgs) (*serviceendpoint.ServiceEndpoint, error) {
+			require.Equal(t, "ConvertAuthenticationScheme", *args.Operation)
+			require.Equal(t, azurermTestServiceEndpointAzureRMID, *args.EndpointId)
+			require.Equal(t, string(WorkloadIdentityFederation), *args.Endpoint.Authorization.Scheme)
+			require.Equal(t, servicePrincipalID, (*args.Endpoint.Authorization.Parameters)["serviceprincipalid"])
+			return &converted, nil
+		}).
+		Times(1)
+	endpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(&converted, nil).
+		Times(2)
+
+	require.Nil(t, r.Update(resourceData, clients))
+	require.Equal(t, azurermTestServiceEndpointAzureRMID.String(), resourceData.Id())
+	require.Equal(t, string(WorkloadIdentityFederation), resourceData.Get("service_endpoint_authentication_scheme"))
+	require.Equal(t, "sc://org/project/name", resourceData.Get("workload_identity_federation_subject"))
+	require.Equal(t, "2024-06-08T00:00:00Z", resourceData.Get("revert_scheme_deadline"))
+}
+
+// verifies that the conversion of an adopted service endpoint does not send the secrets unknown to Terraform
+func TestServiceEndpointAzureRM_Convert_OmitsAdoptedCredentials(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpoint := getManualAuthServiceEndpoint()
+	(*endpoint.Authorization.Parameters)["serviceprincipalkey"] = ""
+	endpoint.Authorization.Scheme = converter.String(string(WorkloadIdentityFederation))
+	resourceData := getResourceData(t, endpoint)
+	resourceData.Set("project_id", azurermTestServiceEndpointAzureRMProjectID.String())
+	resourceData.Set("adopt_existing_credentials", true)
+
+	endpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: endpointClient, Ctx: context.Background()}
+
+	converted := getManualAuthServiceEndpoint()
+	converted.IsReady = converter.Bool(true)
+	endpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(&converted, nil).
+		Times(1)
+	endpointClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args serviceendpoint.UpdateServiceEndpointArgs) (*serviceendpoint.ServiceEndpoint, error) {
+			require.Equal(t, "ConvertAuthenticationScheme", *args.Operation)
+			require.NotContains(t, *args.Endpoint.Authorization.Parameters, "serviceprincipalkey")
+			require.Equal(t, "e31eaaac-47da-4156-b433-9b0538c94b7e", (*args.Endpoint.Authorization.Parameters)["serviceprincipalid"])
+			return &converted, nil
+		}).
+		Times(1)
+
+	require.Nil(t, convertServiceEndpointAzureRM(resourceData, clients, &endpoint))
+}
+
+// verifies that converting the authentication scheme validates rotated credentials before the conversion as well
+func TestServiceEndpointAzureRM_Update_ConversionValidatesRotatedCredentials(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointAzureRM()
+	endpoint := azurermTestServiceEndpointsAzureRM[1]
+	resourceData := getResourceData(t, endpoint)
+	resourceData.Set("project_id", azurermTestServiceEndpointAzureRMProjectID.String())
+	resourceData.Set("secret_version", "1")
+	flattenServiceEndpointAzureRM(resourceData, &endpoint)
+	state := resourceData.State()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id":                             azurermTestServiceEndpointAzureRMProjectID.String(),
+		"service_endpoint_name":                  *endpoint.Name,
+		"description":                            *endpoint.Description,
+		"azurerm_spn_tenantid":                   (*endpoint.Authorization.Parameters)["tenantid"],
+		"azurerm_subscription_id":                (*endpoint.Data)["subscriptionId"],
+		"azurerm_subscription_name":              (*endpoint.Data)["subscriptionName"],
+		"service_endpoint_authentication_scheme": string(WorkloadIdentityFederation),
+		"secret_version":                         "2",
+		"validate_rotation":                      true,
+	})
+	diff, err := r.Diff(context.Background(), state, config, nil)
+	require.Nil(t, err)
+	require.False(t, diff.RequiresNew())
+
+	resourceData, err = schema.InternalMap(r.Schema).Data(state, diff)
+	require.Nil(t, err)
+
+	endpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: endpointClient, Ctx: context.Background()}
+
+	endpointClient.
+		EXPECT().
+		UpdateServiceEndpoint(gomock.Any(), gomock.Any()).
+		Times(0)
+	endpointClient.
+		EXPECT().
+		ExecuteServiceEndpointRequest(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("ExecuteServiceEndpointRequest() Failed")).
+		Times(1)
+
+	err = r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "Validating the rotated credentials")
+	require.Equal(t, "1", resourceData.State().Attributes["secret_version"])
+}
+
+func TestServiceEndpointAzureRM_Diff_ReplacesOnUnsupportedSchemeChange(t *testing.T) {
+	r := ResourceServiceEndpointAzureRM()
+	endpoint := azurermTestServiceEndpointsAzureRM[1]
+	resourceData := getResourceData(t, endpoint)
+	resourceData.Set("project_id", azurermTestServiceEndpointAzureRMProjectID.String())
+	flattenServiceEndpointAzureRM(resourceData, &endpoint)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id":                             azurermTestServiceEndpointAzureRMProjectID.String(),
+		"service_endpoint_name":                  *endpoint.Name,
+		"description":                            *endpoint.Description,
+		"azurerm_spn_tenantid":                   (*endpoint.Authorization.Parameters)["tenantid"],
+		"azurerm_subscription_id":                (*endpoint.Data)["subscriptionId"],
+		"azurerm_subscription_name":              (*endpoint.Data)["subscriptionName"],
+		"service_endpoint_authentication_scheme": string(ManagedServiceIdentity),
+	})
+	diff, err := r.Diff(context.Background(), resourceData.State(), config, nil)
+	require.Nil(t, err)
+	require.True(t, diff.RequiresNew())
+}
+
+// getRotatedServiceEndpointAzureRMResourceData returns the resource data of an update that bumps the secret version
+// and rotates the service principal key
+func getRotatedServiceEndpointAzureRMResourceData(t *testing.T, validateRotation bool) *schema.ResourceData {
+	r := ResourceServiceEndpointAzureRM()
+	endpoint := getManualAuthServiceEndpoint()
+	resourceData := getResourceData(t, endpoint)
+	resourceData.Set("project_id", azurermTestServiceEndpointAzureRMProjectID.String())
+	resourceData.Set("secret_version", "1")
+	flattenServiceEndpointAzureRM(resourceData, &endpoint)
+	state := resourceData.State()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id":                (*endpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String(),
+		"service_endpoint_name":     *endpoint.Name,
+		"description":               *endpoint.Description,
+		"azurerm_spn_tenantid":      (*endpoint.Authorization.Parameters)["tenantid"],
+		"azurerm_subscription_id":   (*endpoint.Data)["subscriptionId"],
+		"azurerm_subscription_name": (*endpoint.Data)["subscriptionName"],
+		"credentials": []interface{}{map[string]interface{}{
+			"serviceprincipalid":  (*endpoint.Authorization.Parameters)["serviceprincipalid"],
+			"serviceprincipalkey": "rotatedserviceprincipalkey",
+		}},
+		"secret_version":    "2",
+		"validate_rotation": validateRotation,
+	})
+	diff, err := r.Diff(context.Background(), state, config, nil)
+	require.Nil(t, err)
+	require.False(t, diff.RequiresNew())
+
+	resourceData, err = schema.InternalMap(r.Schema).Data(state, diff)
+	require.Nil(t, err)
+	require.True(t, credentialsRotated(resourceData))
+	return resourceData
+}
+
+// verifies that bumping the secret version validates the rotated credentials before they are sent
+func TestServiceEndpointAzureRM_Update_ValidatesRotatedCredentials(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointAzureRM()
+	resourceData := getRotatedServiceEndpointAzureRMResourceData(t, true)
+
+	endpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: endpointClient, Ctx: context.Background()}
+
+	rotated := getManualAuthServiceEndpoint()
+	(*rotated.Authorization.Parameters)["serviceprincipalkey"] = "rotatedserviceprincipalkey"
+
+	current := getManualAuthServiceEndpoint()
+	gomock.InOrder(
+		endpointClient.
+			EXPECT().
+			ExecuteServiceEndpointRequest(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, args serviceendpoint.ExecuteServiceEndpointRequestArgs) (*serviceendpoint.ServiceEndpointRequestResult, error) {
+				require.Equal(t, "rotatedserviceprincipalkey", (*args.ServiceEndpointRequest.ServiceEndpointDetails.Authorization.Parameters)["serviceprincipalkey"])
+				return &serviceendpoint.ServiceEndpointRequestResult{StatusCode: converter.String("ok")}, nil
+			}).
+			Times(1),
+		endpointClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+			Return(&current, nil).
+			Times(1),
+		endpointClient.
+			EXPECT().
+			UpdateServiceEndpoint(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, args serviceendpoint.UpdateServiceEndpointArgs) (*serviceendpoint.ServiceEndpoint, error) {
+				require.Equal(t, "rotatedserviceprincipalkey", (*args.Endpoint.Authorization.Parameters)["serviceprincipalkey"])
+				return &rotated, nil
+			}).
+			Times(1),
+		endpointClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+			Return(&rotated, nil).
+			Times(1),
+	)
+
+	require.Nil(t, r.Update(resourceData, clients))
+	require.Equal(t, "2", resourceData.Get("secret_version"))
+}
+
+// verifies that rotated credentials failing validation are not sent and keep the previous secret version in state
+func TestServiceEndpointAzureRM_Update_RotatedCredentialsValidationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointAzureRM()
+	resourceData := getRotatedServiceEndpointAzureRMResourceData(t, true)
+
+	endpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: endpointClient, Ctx: context.Background()}
+
+	endpointClient.
+		EXPECT().
+		UpdateServiceEndpoint(gomock.Any(), gomock.Any()).
+		Times(0)
+	endpointClient.
+		EXPECT().
+		ExecuteServiceEndpointRequest(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("ExecuteServiceEndpointRequest() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "Validating the rotated credentials")
+	require.Contains(t, err.Error(), "ExecuteServiceEndpointRequest() Failed")
+	require.Equal(t, "1", resourceData.State().Attributes["secret_version"])
+}
+
+// verifies that rotated credentials are not validated unless requested
+func TestServiceEndpointAzureRM_Update_RotatedCredentialsWithoutValidation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointAzureRM()
+	resourceData := getRotatedServiceEndpointAzureRMResourceData(t, false)
+
+	endpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: endpointClient, Ctx: context.Background()}
+
+	endpoint := getManualAuthServiceEndpoint()
+	endpointClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, gomock.Any()).
+		Return(&endpoint, nil).
+		Times(1)
+	endpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(&endpoint, nil).
+		Times(2)
+
+	require.Nil(t, r.Update(resourceData, clients))
+}
+
 // This is a little different than most. The steps done, along with the motivation behind each, are as follows:
 //	(1) The service endpoint is configured. The `serviceprincipalkey` is set to `""`, which matches
 //		the Azure DevOps API behavior. The service will intentionally hide the value of
@@ -11,7 +11,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/model"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 func ResourceServiceEndpointBitBucket() *schema.Resource {
@@ -26,7 +25,7 @@ func ResourceServiceEndpointBitBucket() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 
@@ -42,13 +41,14 @@ func ResourceServiceEndpointBitBucket() *schema.Resource {
 		},
 
 		"password": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			DefaultFunc:  schema.EnvDefaultFunc("AZDO_BITBUCKET_SERVICE_CONNECTION_PASSWORD", nil),
-			Description:  "The bitbucket password which should be used.",
-			Sensitive:    true,
-			RequiredWith: []string{"username"},
-			Deprecated:   "Bitbucket Cloud has deprecated app password (username and password) authentication. Use `email` and `api_token` instead.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			DefaultFunc:      schema.EnvDefaultFunc("AZDO_BITBUCKET_SERVICE_CONNECTION_PASSWORD", nil),
+			Description:      "The bitbucket password which should be used.",
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			RequiredWith:     []string{"username"},
+			Deprecated:       "Bitbucket Cloud has deprecated app password (username and password) authentication. Use `email` and `api_token` instead.",
 		},
 
 		"email": {
@@ -61,14 +61,16 @@ func ResourceServiceEndpointBitBucket() *schema.Resource {
 		},
 
 		"api_token": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			DefaultFunc:  schema.EnvDefaultFunc("AZDO_BITBUCKET_SERVICE_CONNECTION_API_TOKEN", nil),
-			Description:  "The bitbucket API token which should be used.",
-			Sensitive:    true,
-			RequiredWith: []string{"email"},
+			Type:             schema.TypeString,
+			Optional:         true,
+			DefaultFunc:      schema.EnvDefaultFunc("AZDO_BITBUCKET_SERVICE_CONNECTION_API_TOKEN", nil),
+			Description:      "The bitbucket API token which should be used.",
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			RequiredWith:     []string{"email"},
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
 
 	return r
 }
@@ -114,7 +116,11 @@ func resourceServiceEndpointBitbucketRead(d *schema.ResourceData, m interface{})
 func resourceServiceEndpointBitbucketUpdate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	serviceEndpoint := expandServiceEndpointBitBucket(d)
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
 	}
 
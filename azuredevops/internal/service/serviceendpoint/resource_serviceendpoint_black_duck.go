@@ -11,7 +11,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 func ResourceServiceEndpointBlackDuck() *schema.Resource {
@@ -26,7 +25,7 @@ func ResourceServiceEndpointBlackDuck() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 
@@ -38,12 +37,15 @@ func ResourceServiceEndpointBlackDuck() *schema.Resource {
 		},
 
 		"api_token": {
-			Type:         schema.TypeString,
-			Required:     true,
-			Sensitive:    true,
-			ValidateFunc: validation.StringIsNotEmpty,
+			Type:             schema.TypeString,
+			Required:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			ValidateFunc:     validation.StringIsNotEmpty,
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
+
 	return r
 }
 
@@ -88,7 +90,11 @@ func resourceServiceEndpointBlackDuckRead(d *schema.ResourceData, m interface{})
 func resourceServiceEndpointBlackDuckUpdate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	serviceEndpoint := expandServiceEndpointBlackDuck(d)
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
 	}
 
@@ -11,7 +11,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 func ResourceServiceEndpointCheckMarxOneService() *schema.Resource {
@@ -26,7 +25,7 @@ func ResourceServiceEndpointCheckMarxOneService() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 
@@ -38,12 +37,13 @@ func ResourceServiceEndpointCheckMarxOneService() *schema.Resource {
 		},
 
 		"api_key": {
-			Type:          schema.TypeString,
-			Optional:      true,
-			Sensitive:     true,
-			ValidateFunc:  validation.StringIsNotWhiteSpace,
-			ConflictsWith: []string{"client_id", "client_secret", "authorization_url"},
-			AtLeastOneOf:  []string{"client_id", "api_key"},
+			Type:             schema.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			ValidateFunc:     validation.StringIsNotWhiteSpace,
+			ConflictsWith:    []string{"client_id", "client_secret", "authorization_url"},
+			AtLeastOneOf:     []string{"client_id", "api_key"},
 		},
 
 		"client_id": {
@@ -55,12 +55,13 @@ func ResourceServiceEndpointCheckMarxOneService() *schema.Resource {
 		},
 
 		"client_secret": {
-			Type:          schema.TypeString,
-			Optional:      true,
-			Sensitive:     true,
-			ValidateFunc:  validation.StringIsNotWhiteSpace,
-			ConflictsWith: []string{"api_key"},
-			RequiredWith:  []string{"client_id"},
+			Type:             schema.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			ValidateFunc:     validation.StringIsNotWhiteSpace,
+			ConflictsWith:    []string{"api_key"},
+			RequiredWith:     []string{"client_id"},
 		},
 
 		"authorization_url": {
@@ -70,6 +71,8 @@ func ResourceServiceEndpointCheckMarxOneService() *schema.Resource {
 			ConflictsWith: []string{"api_key"},
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
+
 	return r
 }
 
@@ -115,9 +118,14 @@ func resourceServiceEndpointCheckMarxOneServiceUpdate(d *schema.ResourceData, m
 	clients := m.(*client.AggregatedClient)
 
 	serviceEndpoint := expandServiceEndpointCheckMarxOneService(d)
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
 	}
+
 	return resourceServiceEndpointCheckMarxOneServiceRead(d, m)
 }
 
@@ -11,7 +11,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 func ResourceServiceEndpointCheckMarxSAST() *schema.Resource {
@@ -26,7 +25,7 @@ func ResourceServiceEndpointCheckMarxSAST() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 
@@ -44,10 +43,11 @@ func ResourceServiceEndpointCheckMarxSAST() *schema.Resource {
 		},
 
 		"password": {
-			Type:         schema.TypeString,
-			Required:     true,
-			Sensitive:    true,
-			ValidateFunc: validation.StringIsNotWhiteSpace,
+			Type:             schema.TypeString,
+			Required:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			ValidateFunc:     validation.StringIsNotWhiteSpace,
 		},
 
 		"team": {
@@ -62,6 +62,8 @@ func ResourceServiceEndpointCheckMarxSAST() *schema.Resource {
 			ValidateFunc: validation.StringIsNotWhiteSpace,
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
+
 	return r
 }
 
@@ -107,9 +109,14 @@ func resourceServiceEndpointCheckMarxSASTUpdate(d *schema.ResourceData, m interf
 	clients := m.(*client.AggregatedClient)
 
 	serviceEndpoint := expandServiceEndpointCheckMarxSAST(d)
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
 	}
+
 	return resourceServiceEndpointCheckMarxSASTRead(d, m)
 }
 
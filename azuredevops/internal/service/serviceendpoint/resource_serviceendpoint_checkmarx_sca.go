@@ -11,7 +11,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 func ResourceServiceEndpointCheckMarxSCA() *schema.Resource {
@@ -26,7 +25,7 @@ func ResourceServiceEndpointCheckMarxSCA() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 
@@ -62,10 +61,11 @@ func ResourceServiceEndpointCheckMarxSCA() *schema.Resource {
 		},
 
 		"password": {
-			Type:         schema.TypeString,
-			Required:     true,
-			Sensitive:    true,
-			ValidateFunc: validation.StringIsNotWhiteSpace,
+			Type:             schema.TypeString,
+			Required:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			ValidateFunc:     validation.StringIsNotWhiteSpace,
 		},
 
 		"team": {
@@ -74,6 +74,8 @@ func ResourceServiceEndpointCheckMarxSCA() *schema.Resource {
 			ValidateFunc: validation.StringIsNotWhiteSpace,
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
+
 	return r
 }
 
@@ -119,9 +121,14 @@ func resourceServiceEndpointCheckMarxSCAUpdate(d *schema.ResourceData, m interfa
 	clients := m.(*client.AggregatedClient)
 
 	serviceEndpoint := expandServiceEndpointCheckMarxSCA(d)
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
 	}
+
 	return resourceServiceEndpointCheckMarxSCARead(d, m)
 }
 
@@ -11,7 +11,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 // ResourceServiceEndpointDockerRegistry schema and implementation for docker registry service endpoint resource
@@ -27,7 +26,7 @@ func ResourceServiceEndpointDockerRegistry() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 
@@ -45,11 +44,12 @@ func ResourceServiceEndpointDockerRegistry() *schema.Resource {
 			Description: "The DockerRegistry username which should be used.",
 		},
 		"docker_password": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			DefaultFunc: schema.EnvDefaultFunc("AZDO_DOCKERREGISTRY_SERVICE_CONNECTION_PASSWORD", nil),
-			Description: "The DockerRegistry password which should be used.",
-			Sensitive:   true,
+			Type:             schema.TypeString,
+			Optional:         true,
+			DefaultFunc:      schema.EnvDefaultFunc("AZDO_DOCKERREGISTRY_SERVICE_CONNECTION_PASSWORD", nil),
+			Description:      "The DockerRegistry password which should be used.",
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
 		},
 		"docker_email": {
 			Type:        schema.TypeString,
@@ -65,6 +65,8 @@ func ResourceServiceEndpointDockerRegistry() *schema.Resource {
 			ForceNew:     true,
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
+
 	return r
 }
 
@@ -109,7 +111,11 @@ func resourceServiceEndpointDockerRegistryRead(d *schema.ResourceData, m interfa
 func resourceServiceEndpointDockerRegistryUpdate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	serviceEndpoint := expandServiceEndpointDockerRegistry(d)
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
 	}
 
@@ -168,6 +168,12 @@ func TestServiceEndpointDockerRegistry_Update_DoesNotSwallowError(t *testing.T)
 		EndpointId: dockerRegistryTestServiceEndpoint.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(expectedArgs.Endpoint, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
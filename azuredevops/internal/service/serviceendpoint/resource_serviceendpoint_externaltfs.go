@@ -11,7 +11,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 func ResourceServiceEndpointExternalTFS() *schema.Resource {
@@ -26,7 +25,7 @@ func ResourceServiceEndpointExternalTFS() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 
@@ -46,17 +45,19 @@ func ResourceServiceEndpointExternalTFS() *schema.Resource {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"personal_access_token": {
-						Type:         schema.TypeString,
-						Required:     true,
-						DefaultFunc:  schema.EnvDefaultFunc("AZDO_PERSONAL_ACCESS_TOKEN", nil),
-						Description:  "Personal access tokens are applicable only for connections targeting Azure DevOps organization or TFS 2017 (and higher)",
-						Sensitive:    true,
-						ValidateFunc: validation.StringIsNotEmpty,
+						Type:             schema.TypeString,
+						Required:         true,
+						DefaultFunc:      schema.EnvDefaultFunc("AZDO_PERSONAL_ACCESS_TOKEN", nil),
+						Description:      "Personal access tokens are applicable only for connections targeting Azure DevOps organization or TFS 2017 (and higher)",
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
+						ValidateFunc:     validation.StringIsNotEmpty,
 					},
 				},
 			},
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
 
 	return r
 }
@@ -103,7 +104,11 @@ func resourceServiceEndpointExternalTFSRead(d *schema.ResourceData, m interface{
 func resourceServiceEndpointExternalTFSUpdate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	serviceEndpoint := expandServiceEndpointExternalTFS(d)
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
 	}
 
@@ -11,7 +11,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 // ResourceServiceEndpointGeneric schema and implementation for generic service endpoint resource
@@ -27,7 +26,7 @@ func ResourceServiceEndpointGeneric() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 
@@ -47,13 +46,16 @@ func ResourceServiceEndpointGeneric() *schema.Resource {
 		},
 
 		"password": {
-			Type:        schema.TypeString,
-			DefaultFunc: schema.EnvDefaultFunc("AZDO_GENERIC_SERVICE_CONNECTION_PASSWORD", nil),
-			Description: "The password or token key to use for the generic service connection.",
-			Sensitive:   true,
-			Optional:    true,
+			Type:             schema.TypeString,
+			DefaultFunc:      schema.EnvDefaultFunc("AZDO_GENERIC_SERVICE_CONNECTION_PASSWORD", nil),
+			Description:      "The password or token key to use for the generic service connection.",
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			Optional:         true,
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
+
 	return r
 }
 
@@ -98,7 +100,11 @@ func resourceServiceEndpointGenericRead(d *schema.ResourceData, m interface{}) e
 func resourceServiceEndpointGenericUpdate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	serviceEndpoint := expandServiceEndpointGeneric(d)
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
 	}
 
@@ -12,7 +12,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 func ResourceServiceEndpointGenericGit() *schema.Resource {
@@ -27,7 +26,7 @@ func ResourceServiceEndpointGenericGit() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 
@@ -45,11 +44,12 @@ func ResourceServiceEndpointGenericGit() *schema.Resource {
 			Optional:    true,
 		},
 		"password": {
-			Type:        schema.TypeString,
-			DefaultFunc: schema.EnvDefaultFunc("AZDO_GENERIC_GIT_SERVICE_CONNECTION_PASSWORD", nil),
-			Description: "The password or token key to use for the GenericGit git service connection.",
-			Sensitive:   true,
-			Optional:    true,
+			Type:             schema.TypeString,
+			DefaultFunc:      schema.EnvDefaultFunc("AZDO_GENERIC_GIT_SERVICE_CONNECTION_PASSWORD", nil),
+			Description:      "The password or token key to use for the GenericGit git service connection.",
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			Optional:         true,
 		},
 		"enable_pipelines_access": {
 			Type:        schema.TypeBool,
@@ -58,6 +58,7 @@ func ResourceServiceEndpointGenericGit() *schema.Resource {
 			Optional:    true,
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
 
 	return r
 }
@@ -103,7 +104,11 @@ func resourceServiceEndpointGenericGitRead(d *schema.ResourceData, m interface{}
 func resourceServiceEndpointGenericGitUpdate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	serviceEndpoint := expandServiceEndpointGenericGit(d)
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Upating service endpoint in Azure DevOps: %+v", err)
 	}
 
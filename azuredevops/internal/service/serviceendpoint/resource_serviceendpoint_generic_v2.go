@@ -3,6 +3,8 @@ package serviceendpoint
 import (
 	"context"
 	"fmt"
+	"maps"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -33,11 +35,14 @@ type EndpointConfig struct {
 	AuthType            string
 	AuthData            map[string]string
 	Data                map[string]string
+	// UnknownAuthData and UnknownData hold the keys whose values are not known at plan time
+	UnknownAuthData map[string]bool
+	UnknownData     map[string]bool
 }
 
 // ResourceServiceEndpointGenericV2 schema and implementation for generic service endpoint resource
 func ResourceServiceEndpointGenericV2() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: resourceServiceEndpointGenericV2Create,
 		ReadContext:   resourceServiceEndpointGenericV2Read,
 		UpdateContext: resourceServiceEndpointGenericV2Update,
@@ -48,7 +53,7 @@ func ResourceServiceEndpointGenericV2() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer:      tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer:      importServiceEndpoint(),
 		CustomizeDiff: customizeServiceEndpointGenericV2Diff,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -94,10 +99,11 @@ func ResourceServiceEndpointGenericV2() *schema.Resource {
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			"authorization_parameters": {
-				Type:      schema.TypeMap,
-				Optional:  true,
-				ForceNew:  false,
-				Sensitive: true,
+				Type:             schema.TypeMap,
+				Optional:         true,
+				ForceNew:         false,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressAdoptedCredential,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -109,8 +115,16 @@ func ResourceServiceEndpointGenericV2() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"adopt_existing_credentials": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
+	maps.Copy(r.Schema, credentialRotationSchema())
+
+	return r
 }
 
 // InitServiceEndpointTypes loads all service endpoint types from Azure DevOps
@@ -172,9 +186,9 @@ func validateAuthScheme(availableType *serviceendpoint.ServiceEndpointType, conf
 }
 
 // validateFields ensures that the provided configuration fields match the expected fields
-func validateFields(configFields map[string]string, possibleFields map[string]forminput.InputDescriptor, fieldType, endpointType string, planTime bool) error {
-	// Skip validation at plan time if fields are empty (known-after-apply)
-	if planTime && len(configFields) == 0 {
+func validateFields(configFields map[string]string, unknownValues map[string]bool, possibleFields map[string]forminput.InputDescriptor, fieldType, endpointType string, planTime bool) error {
+	// Skip validation at plan time if the whole map is not known yet (known-after-apply)
+	if planTime && configFields == nil {
 		return nil
 	}
 
@@ -191,6 +205,8 @@ func validateFields(configFields map[string]string, possibleFields map[string]fo
 		for k := range possibleFields {
 			validFields = append(validFields, k)
 		}
+		sort.Strings(invalidFields)
+		sort.Strings(validFields)
 		return fmt.Errorf("service endpoint type '%s' does not support %s field(s): %v. Supported fields: %v",
 			endpointType, fieldType, invalidFields, validFields)
 	}
@@ -214,13 +230,59 @@ func validateFields(configFields map[string]string, possibleFields map[string]fo
 		for k, v := range missingFields {
 			missingFieldList = append(missingFieldList, fmt.Sprintf("%s: %s", k, v))
 		}
+		sort.Strings(missingFieldList)
 		return fmt.Errorf("service endpoint type '%s' is missing required %s fields: %v",
 			endpointType, fieldType, missingFieldList)
 	}
 
+	// Check that fields limited to a set of values use one of them
+	keys := make([]string, 0, len(configFields))
+	for key := range configFields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if unknownValues[key] {
+			continue
+		}
+		possibleValues := getLimitedPossibleValues(possibleFields[key])
+		if len(possibleValues) == 0 {
+			continue
+		}
+		if !containsFold(possibleValues, configFields[key]) {
+			return fmt.Errorf("service endpoint type '%s' does not support value '%s' for %s field '%s'. Possible values: %v",
+				endpointType, configFields[key], fieldType, key, possibleValues)
+		}
+	}
+
 	return nil
 }
 
+// getLimitedPossibleValues returns the values an input is restricted to, or nil if it accepts any value
+func getLimitedPossibleValues(descriptor forminput.InputDescriptor) []string {
+	if descriptor.Values == nil || descriptor.Values.PossibleValues == nil ||
+		descriptor.Values.IsLimitedToPossibleValues == nil || !*descriptor.Values.IsLimitedToPossibleValues {
+		return nil
+	}
+
+	possibleValues := make([]string, 0, len(*descriptor.Values.PossibleValues))
+	for _, value := range *descriptor.Values.PossibleValues {
+		if value.Value != nil {
+			possibleValues = append(possibleValues, *value.Value)
+		}
+	}
+	return possibleValues
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
 // validateServiceEndpointType validates that the configuration matches the endpoint type requirements
 func validateServiceEndpointType(availableType *serviceendpoint.ServiceEndpointType, config EndpointConfig, planTime bool) error {
 	if availableType == nil || availableType.Name == nil {
@@ -237,7 +299,7 @@ func validateServiceEndpointType(availableType *serviceendpoint.ServiceEndpointT
 		}
 	}
 
-	if err := validateFields(config.Data, possibleData, "data", *availableType.Name, planTime); err != nil {
+	if err := validateFields(config.Data, config.UnknownData, possibleData, "data", *availableType.Name, planTime); err != nil {
 		return err
 	}
 
@@ -247,7 +309,7 @@ func validateServiceEndpointType(availableType *serviceendpoint.ServiceEndpointT
 		return err
 	}
 
-	if err := validateFields(config.AuthData, possibleAuthData, "auth", *availableType.Name, planTime); err != nil {
+	if err := validateFields(config.AuthData, config.UnknownAuthData, possibleAuthData, "auth", *availableType.Name, planTime); err != nil {
 		return err
 	}
 
@@ -317,42 +379,12 @@ func resourceServiceEndpointGenericV2Create(ctx context.Context, d *schema.Resou
 	}
 
 	d.SetId(serviceEndpoint.Id.String())
-	if sharedProjectIDs, ok := d.GetOk("shared_project_ids"); ok {
-		projectIDsList := sharedProjectIDs.([]interface{})
-		if len(projectIDsList) > 0 {
-			name := d.Get("name").(string)
-			description := d.Get("description").(string)
-
-			// Build an array of project references
-			projectReferences := make([]serviceendpoint.ServiceEndpointProjectReference, 0, len(projectIDsList))
-			for _, projectIDRaw := range projectIDsList {
-				projectIDStr := projectIDRaw.(string)
-				id, err := uuid.Parse(projectIDStr)
-				if err != nil {
-					return diag.FromErr(fmt.Errorf("invalid shared project ID '%s': %v", projectIDStr, err))
-				}
-
-				projectReferences = append(projectReferences, serviceendpoint.ServiceEndpointProjectReference{
-					ProjectReference: &serviceendpoint.ProjectReference{
-						Id: &id,
-					},
-					Name:        converter.String(name),
-					Description: converter.String(description),
-				})
-			}
-
-			// Make a single API call with all project references
-			err := clients.ServiceEndpointClient.ShareServiceEndpoint(
-				clients.Ctx,
-				serviceendpoint.ShareServiceEndpointArgs{
-					EndpointProjectReferences: &projectReferences,
-					EndpointId:                serviceEndpoint.Id,
-				},
-			)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("failed to share service endpoint with projects: %v", err))
-			}
-		}
+	references, err := expandServiceEndpointProjectReferences(tfhelper.ExpandStringList(d.Get("shared_project_ids").([]interface{})), d.Get("name").(string), d.Get("description").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("invalid shared project ID: %v", err))
+	}
+	if err := shareServiceEndpoint(clients, serviceEndpoint.Id, references); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to share service endpoint with projects: %v", err))
 	}
 	return resourceServiceEndpointGenericV2Read(ctx, d, m)
 }
@@ -427,6 +459,14 @@ func createGenericV2ServiceEndpoint(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return nil, fmt.Errorf("error creating service endpoint: %w", err)
 	}
+	if createdEndpoint == nil || createdEndpoint.Id == nil {
+		return createdEndpoint, nil
+	}
+
+	// Wait for the service endpoint to be ready, failed service endpoints are deleted again
+	if err := waitForServiceEndpointReady(clients, createdEndpoint, &projectUUID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return nil, cleanupFailedServiceEndpoint(d, clients, createdEndpoint, err)
+	}
 
 	return createdEndpoint, nil
 }
@@ -517,15 +557,12 @@ func resourceServiceEndpointGenericV2Read(ctx context.Context, d *schema.Resourc
 		}
 	}
 
-	// Populate shared_project_ids
+	// Populate shared_project_ids with the managed projects only. Shares of other projects, e.g. created by
+	// azuredevops_serviceendpoint_share, are ignored.
 	var sharedProjectIDs []string
-	// ServiceEndpointProjectReferences can be null, so we check for that
-	if serviceEndpoint.ServiceEndpointProjectReferences != nil {
-		for _, ref := range *serviceEndpoint.ServiceEndpointProjectReferences {
-			if ref.ProjectReference != nil && ref.ProjectReference.Id != nil &&
-				ref.ProjectReference.Id.String() != d.Get("project_id").(string) {
-				sharedProjectIDs = append(sharedProjectIDs, ref.ProjectReference.Id.String())
-			}
+	for _, projectID := range tfhelper.ExpandStringList(d.Get("shared_project_ids").([]interface{})) {
+		if findServiceEndpointProjectReference(serviceEndpoint, projectID) != nil {
+			sharedProjectIDs = append(sharedProjectIDs, projectID)
 		}
 	}
 	err = d.Set("shared_project_ids", sharedProjectIDs)
@@ -554,73 +591,32 @@ func resourceServiceEndpointGenericV2Update(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
+	if err := validateRotatedServiceEndpoint(d, clients, updatedEndpoint); err != nil {
+		return diag.FromErr(err)
+	}
+
 	// Update service endpoint in Azure DevOps
 	_, err = updateServiceEndpointGenericV2(ctx, clients, updatedEndpoint)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	// Handle shared_project_ids updates
+	// Handle shared_project_ids updates. Only the projects removed from shared_project_ids are unshared.
 	if d.HasChange("shared_project_ids") {
 		oldVal, newVal := d.GetChange("shared_project_ids")
 		oldSet := schema.NewSet(schema.HashString, oldVal.([]interface{}))
 		newSet := schema.NewSet(schema.HashString, newVal.([]interface{}))
 
-		projectIDsList := newSet.Difference(oldSet).List()
-
-		if len(projectIDsList) > 0 {
-			name := d.Get("name").(string)
-			description := d.Get("description").(string)
-
-			// Build an array of project references
-			projectReferences := make([]serviceendpoint.ServiceEndpointProjectReference, 0, len(projectIDsList))
-			for _, projectIDRaw := range projectIDsList {
-				projectIDStr := projectIDRaw.(string)
-				id, err := uuid.Parse(projectIDStr)
-				if err != nil {
-					return diag.FromErr(fmt.Errorf("invalid shared project ID '%s': %v", projectIDStr, err))
-				}
-
-				projectReferences = append(projectReferences, serviceendpoint.ServiceEndpointProjectReference{
-					ProjectReference: &serviceendpoint.ProjectReference{
-						Id: &id,
-					},
-					Name:        converter.String(name),
-					Description: converter.String(description),
-				})
-			}
-
-			// Make a single API call with all project references
-			err := clients.ServiceEndpointClient.ShareServiceEndpoint(
-				clients.Ctx,
-				serviceendpoint.ShareServiceEndpointArgs{
-					EndpointProjectReferences: &projectReferences,
-					EndpointId:                currentEndpoint.Id,
-				},
-			)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("failed to share service endpoint with projects: %v", err))
-			}
+		references, err := expandServiceEndpointProjectReferences(tfhelper.ExpandStringSet(newSet.Difference(oldSet)), d.Get("name").(string), d.Get("description").(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("invalid shared project ID: %v", err))
+		}
+		if err := shareServiceEndpoint(clients, currentEndpoint.Id, references); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to share service endpoint with projects: %v", err))
 		}
 
-		// Convert []interface{} to []string
-		removeProjects := oldSet.Difference(newSet).List()
-		if len(removeProjects) > 0 {
-			removeProjectsStr := make([]string, len(removeProjects))
-			for i, v := range removeProjects {
-				removeProjectsStr[i] = v.(string)
-			}
-			// Remove old shared projects
-			err = clients.ServiceEndpointClient.DeleteServiceEndpoint(
-				clients.Ctx,
-				serviceendpoint.DeleteServiceEndpointArgs{
-					ProjectIds: &removeProjectsStr,
-					EndpointId: currentEndpoint.Id,
-				},
-			)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("failed to remove shared service endpoint from projects: %v", err))
-			}
+		if err := unshareServiceEndpoint(clients, currentEndpoint.Id, tfhelper.ExpandStringSet(oldSet.Difference(newSet))); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to remove shared service endpoint from projects: %v", err))
 		}
 	}
 
@@ -642,8 +638,8 @@ func updateServiceEndpointFromResourceData(d *schema.ResourceData, endpoint *ser
 		endpoint.Url = converter.String(d.Get("server_url").(string))
 	}
 
-	// Handle authorization updates if any auth fields changed
-	if d.HasChange("authorization_scheme") || d.HasChange("authorization_parameters") {
+	// Handle authorization updates if any auth fields changed or the credentials are rotated
+	if d.HasChange("authorization_scheme") || d.HasChange("authorization_parameters") || credentialsRotated(d) {
 		authScheme, authParams, err := getAuthorizationDetails(d)
 		if err != nil {
 			return nil, fmt.Errorf("error processing authorization details: %w", err)
@@ -726,11 +722,6 @@ func getAuthorizationDetails(d *schema.ResourceData) (string, map[string]string,
 	return getAuthorizationDetailsRaw(d.Get("authorization_scheme"), d.Get("authorization_parameters"))
 }
 
-// getAuthorizationDetailsFromDiff extracts auth scheme and params from ResourceDiff
-func getAuthorizationDetailsFromDiff(d *schema.ResourceDiff) (string, map[string]string, error) {
-	return getAuthorizationDetailsRaw(d.Get("authorization_scheme"), d.Get("authorization_parameters"))
-}
-
 // getServiceEndpointGenericV2 retrieves a service endpoint from Azure DevOps
 func getServiceEndpointGenericV2(ctx context.Context, clients *client.AggregatedClient, endpointID, projectID string) (*serviceendpoint.ServiceEndpoint, error) {
 	endpointUUID, err := uuid.Parse(endpointID)
@@ -788,28 +779,64 @@ func deleteServiceEndpointGenericV2(ctx context.Context, clients *client.Aggrega
 
 // customizeServiceEndpointGenericV2Diff validates the service endpoint configuration during the planning phase
 func customizeServiceEndpointGenericV2Diff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
-	// Only validate on resource creation changes
-	if d.Id() != "" {
+	// Validate on creation, and on updates that change the validated fields
+	if d.Id() != "" && !d.HasChanges("parameters", "authorization_parameters") {
 		return nil
 	}
 
-	serviceEndpointType := d.Get("type").(string)
-	authScheme, authParams, err := getAuthorizationDetailsFromDiff(d)
+	// The type and scheme select the metadata to validate against, nothing can be checked without them
+	if !d.NewValueKnown("type") || !d.NewValueKnown("authorization_scheme") {
+		return nil
+	}
+
+	authParams, unknownAuthParams, err := getStringMapFromDiff(d, "authorization_parameters")
 	if err != nil {
 		return err
 	}
 
-	data, err := toStringMap(d.Get("parameters"), "parameters")
+	data, unknownData, err := getStringMapFromDiff(d, "parameters")
 	if err != nil {
 		return err
 	}
 
 	config := EndpointConfig{
-		ServiceEndpointType: serviceEndpointType,
-		AuthType:            authScheme,
+		ServiceEndpointType: d.Get("type").(string),
+		AuthType:            d.Get("authorization_scheme").(string),
 		AuthData:            authParams,
 		Data:                data,
+		UnknownAuthData:     unknownAuthParams,
+		UnknownData:         unknownData,
 	}
 
 	return validateServiceEndpointSchema(m.(*client.AggregatedClient), config, true)
 }
+
+// getStringMapFromDiff returns the planned value of a string map and the keys whose values are not known yet.
+// The map is nil if the attribute itself is not known yet.
+func getStringMapFromDiff(d *schema.ResourceDiff, key string) (map[string]string, map[string]bool, error) {
+	if !d.NewValueKnown(key) {
+		return nil, nil, nil
+	}
+
+	values, err := toStringMap(d.Get(key), key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unknownValues := make(map[string]bool)
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return values, unknownValues, nil
+	}
+	rawMap := rawConfig.GetAttr(key)
+	if rawMap.IsNull() || !rawMap.IsKnown() {
+		return values, unknownValues, nil
+	}
+	for it := rawMap.ElementIterator(); it.Next(); {
+		k, v := it.Element()
+		if !v.IsKnown() {
+			unknownValues[k.AsString()] = true
+		}
+	}
+	return values, unknownValues, nil
+}
@@ -0,0 +1,321 @@
+//go:build (all || resource_serviceendpoint_generic_v2) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_generic_v2
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/forminput"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var genericV2TestServiceEndpointType = serviceendpoint.ServiceEndpointType{
+	Name:        converter.String("marketplaceext"),
+	DisplayName: converter.String("Marketplace Extension"),
+	InputDescriptors: &[]forminput.InputDescriptor{
+		{
+			Id:         converter.String("region"),
+			Name:       converter.String("Region"),
+			Validation: &forminput.InputValidation{IsRequired: converter.Bool(true)},
+			Values: &forminput.InputValues{
+				IsLimitedToPossibleValues: converter.Bool(true),
+				PossibleValues: &[]forminput.InputValue{
+					{Value: converter.String("eu")},
+					{Value: converter.String("us")},
+				},
+			},
+		},
+		{
+			Id:   converter.String("releaseUrl"),
+			Name: converter.String("Release URL"),
+			Values: &forminput.InputValues{
+				IsLimitedToPossibleValues: converter.Bool(false),
+				PossibleValues: &[]forminput.InputValue{
+					{Value: converter.String("https://releases.example.com")},
+				},
+			},
+		},
+	},
+	AuthenticationSchemes: &[]serviceendpoint.ServiceEndpointAuthenticationScheme{
+		{
+			Scheme: converter.String("Token"),
+			InputDescriptors: &[]forminput.InputDescriptor{
+				{
+					Id:         converter.String("apitoken"),
+					Name:       converter.String("API Token"),
+					Validation: &forminput.InputValidation{IsRequired: converter.Bool(true)},
+				},
+			},
+		},
+	},
+}
+
+func genericV2TestConfig() EndpointConfig {
+	return EndpointConfig{
+		ServiceEndpointType: "marketplaceext",
+		AuthType:            "Token",
+		AuthData:            map[string]string{"apitoken": "secret"},
+		Data:                map[string]string{"region": "eu"},
+	}
+}
+
+func TestServiceEndpointGenericV2_Validate_Valid(t *testing.T) {
+	config := genericV2TestConfig()
+	config.Data["region"] = "EU"
+	config.Data["releaseUrl"] = "https://other.example.com"
+
+	require.NoError(t, validateServiceEndpointType(&genericV2TestServiceEndpointType, config, true))
+}
+
+func TestServiceEndpointGenericV2_Validate_UnknownField(t *testing.T) {
+	config := genericV2TestConfig()
+	config.Data["unknown"] = "value"
+
+	err := validateServiceEndpointType(&genericV2TestServiceEndpointType, config, true)
+	require.ErrorContains(t, err, "does not support data field(s): [unknown]")
+}
+
+func TestServiceEndpointGenericV2_Validate_MissingRequiredField(t *testing.T) {
+	config := genericV2TestConfig()
+	config.Data = map[string]string{}
+
+	err := validateServiceEndpointType(&genericV2TestServiceEndpointType, config, true)
+	require.ErrorContains(t, err, "is missing required data fields: [region: Region]")
+
+	config = genericV2TestConfig()
+	config.AuthData = map[string]string{}
+
+	err = validateServiceEndpointType(&genericV2TestServiceEndpointType, config, true)
+	require.ErrorContains(t, err, "is missing required auth fields: [apitoken: API Token]")
+}
+
+func TestServiceEndpointGenericV2_Validate_InvalidPossibleValue(t *testing.T) {
+	config := genericV2TestConfig()
+	config.Data["region"] = "asia"
+
+	err := validateServiceEndpointType(&genericV2TestServiceEndpointType, config, true)
+	require.ErrorContains(t, err, "does not support value 'asia' for data field 'region'. Possible values: [eu us]")
+}
+
+func TestServiceEndpointGenericV2_Validate_UnsupportedAuthorizationScheme(t *testing.T) {
+	config := genericV2TestConfig()
+	config.AuthType = "UsernamePassword"
+
+	err := validateServiceEndpointType(&genericV2TestServiceEndpointType, config, true)
+	require.ErrorContains(t, err, "does not support authentication scheme 'UsernamePassword'. Supported schemes: [Token]")
+}
+
+func TestServiceEndpointGenericV2_Validate_SkipsUnknownValuesAtPlanTime(t *testing.T) {
+	config := genericV2TestConfig()
+	config.Data["region"] = "74D93920-ED26-11E3-AC10-0800200C9A66"
+	config.UnknownData = map[string]bool{"region": true}
+	config.AuthData = nil
+
+	require.NoError(t, validateServiceEndpointType(&genericV2TestServiceEndpointType, config, true))
+
+	config.UnknownData = nil
+	err := validateServiceEndpointType(&genericV2TestServiceEndpointType, config, true)
+	require.ErrorContains(t, err, "does not support value")
+}
+
+// verifies that projects the service endpoint has been shared with outside of shared_project_ids, e.g. by
+// azuredevops_serviceendpoint_share, are not reported as drift
+func TestServiceEndpointGenericV2_Read_IgnoresUnmanagedSharedProjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: endpointClient, Ctx: context.Background()}
+
+	endpoint := getGenericV2TestServiceEndpoint()
+	managedProjectID := uuid.New()
+	unmanagedProjectID := uuid.New()
+	endpoint.ServiceEndpointProjectReferences = &[]serviceendpoint.ServiceEndpointProjectReference{
+		(*endpoint.ServiceEndpointProjectReferences)[0],
+		{ProjectReference: &serviceendpoint.ProjectReference{Id: &managedProjectID}},
+		{ProjectReference: &serviceendpoint.ProjectReference{Id: &unmanagedProjectID}},
+	}
+
+	endpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(endpoint, nil).
+		Times(1)
+
+	resourceData := getGenericV2TestResourceData(t, endpoint, []interface{}{managedProjectID.String()})
+	diags := resourceServiceEndpointGenericV2Read(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, []interface{}{managedProjectID.String()}, resourceData.Get("shared_project_ids"))
+}
+
+// verifies that an update only unshares the projects removed from shared_project_ids
+func TestServiceEndpointGenericV2_Update_UnsharesRemovedProjectsOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: endpointClient, Ctx: context.Background()}
+
+	endpoint := getGenericV2TestServiceEndpoint()
+	keptProjectID := uuid.New()
+	removedProjectID := uuid.New()
+	unmanagedProjectID := uuid.New()
+	endpoint.ServiceEndpointProjectReferences = &[]serviceendpoint.ServiceEndpointProjectReference{
+		(*endpoint.ServiceEndpointProjectReferences)[0],
+		{ProjectReference: &serviceendpoint.ProjectReference{Id: &keptProjectID}},
+		{ProjectReference: &serviceendpoint.ProjectReference{Id: &removedProjectID}},
+		{ProjectReference: &serviceendpoint.ProjectReference{Id: &unmanagedProjectID}},
+	}
+
+	r := ResourceServiceEndpointGenericV2()
+	state := getGenericV2TestResourceData(t, endpoint, []interface{}{keptProjectID.String(), removedProjectID.String()}).State()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id":               state.Attributes["project_id"],
+		"shared_project_ids":       []interface{}{keptProjectID.String()},
+		"name":                     *endpoint.Name,
+		"description":              *endpoint.Description,
+		"type":                     *endpoint.Type,
+		"server_url":               *endpoint.Url,
+		"authorization_scheme":     *endpoint.Authorization.Scheme,
+		"authorization_parameters": map[string]interface{}{"apitoken": "secret"},
+		"parameters":               map[string]interface{}{"region": "eu"},
+	})
+	diff, err := r.Diff(context.Background(), state, config, clients)
+	require.Nil(t, err)
+	resourceData, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.Nil(t, err)
+
+	endpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(endpoint, nil).
+		Times(2)
+	endpointClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, gomock.Any()).
+		Return(endpoint, nil).
+		Times(1)
+	endpointClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+			EndpointId: endpoint.Id,
+			ProjectIds: &[]string{removedProjectID.String()},
+		}).
+		Return(nil).
+		Times(1)
+
+	diags := resourceServiceEndpointGenericV2Update(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, []interface{}{keptProjectID.String()}, resourceData.Get("shared_project_ids"))
+}
+
+// verifies that a generic service endpoint which fails to become ready is deleted again
+func TestServiceEndpointGenericV2_Create_CleansUpFailedServiceEndpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: endpointClient, Ctx: context.Background()}
+
+	endpoint := getGenericV2TestServiceEndpoint()
+	projectID := (*endpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id
+	resourceData := getGenericV2TestResourceData(t, endpoint, nil)
+	resourceData.SetId("")
+
+	failedEndpoint := *endpoint
+	failedEndpoint.IsReady = converter.Bool(false)
+	failedEndpoint.OperationStatus = map[string]interface{}{
+		"state":         "Failed",
+		"statusMessage": "The endpoint could not be provisioned",
+	}
+	created := endpointClient.
+		EXPECT().
+		CreateServiceEndpoint(gomock.Any(), gomock.Any()).
+		Return(endpoint, nil).
+		Times(1)
+	failed := endpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: endpoint.Id,
+			Project:    converter.String(projectID.String()),
+		}).
+		Return(&failedEndpoint, nil).
+		After(created).
+		Times(1)
+	deleted := endpointClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+			EndpointId: endpoint.Id,
+			ProjectIds: &[]string{projectID.String()},
+		}).
+		Return(nil).
+		After(failed).
+		Times(1)
+	endpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(&serviceendpoint.ServiceEndpoint{}, nil).
+		After(deleted).
+		Times(1)
+
+	config := genericV2TestConfig()
+	_, err := createGenericV2ServiceEndpoint(context.Background(), resourceData, clients, &config)
+
+	var opErr *serviceEndpointOperationError
+	require.ErrorAs(t, err, &opErr)
+	require.Equal(t, "The endpoint could not be provisioned", opErr.StatusMessage)
+	require.Contains(t, err.Error(), "has been deleted")
+}
+
+func getGenericV2TestServiceEndpoint() *serviceendpoint.ServiceEndpoint {
+	id := uuid.New()
+	projectID := uuid.New()
+	return &serviceendpoint.ServiceEndpoint{
+		Id:          &id,
+		Name:        converter.String("marketplace"),
+		Description: converter.String("Managed by Terraform"),
+		Type:        converter.String("marketplaceext"),
+		Url:         converter.String("https://marketplace.example.com"),
+		Owner:       converter.String("library"),
+		IsReady:     converter.Bool(true),
+		Authorization: &serviceendpoint.EndpointAuthorization{
+			Scheme:     converter.String("Token"),
+			Parameters: &map[string]string{},
+		},
+		Data: &map[string]string{"region": "eu"},
+		ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+			{
+				ProjectReference: &serviceendpoint.ProjectReference{Id: &projectID},
+				Name:             converter.String("marketplace"),
+				Description:      converter.String("Managed by Terraform"),
+			},
+		},
+	}
+}
+
+func getGenericV2TestResourceData(t *testing.T, endpoint *serviceendpoint.ServiceEndpoint, sharedProjectIDs []interface{}) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointGenericV2().Schema, map[string]interface{}{
+		"project_id":               (*endpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String(),
+		"shared_project_ids":       sharedProjectIDs,
+		"name":                     *endpoint.Name,
+		"description":              *endpoint.Description,
+		"type":                     *endpoint.Type,
+		"server_url":               *endpoint.Url,
+		"authorization_scheme":     *endpoint.Authorization.Scheme,
+		"authorization_parameters": map[string]interface{}{"apitoken": "secret"},
+		"parameters":               map[string]interface{}{"region": "eu"},
+	})
+	resourceData.SetId(endpoint.Id.String())
+	return resourceData
+}
@@ -12,7 +12,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 // ResourceServiceEndpointGitHub schema and implementation for github service endpoint resource
@@ -28,7 +27,7 @@ func ResourceServiceEndpointGitHub() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 	maps.Copy(r.Schema, map[string]*schema.Schema{
@@ -40,12 +39,13 @@ func ResourceServiceEndpointGitHub() *schema.Resource {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"personal_access_token": {
-						Type:         schema.TypeString,
-						Required:     true,
-						DefaultFunc:  schema.EnvDefaultFunc("AZDO_GITHUB_SERVICE_CONNECTION_PAT", nil),
-						Description:  "The GitHub personal access token which should be used.",
-						Sensitive:    true,
-						ValidateFunc: validation.StringIsNotWhiteSpace,
+						Type:             schema.TypeString,
+						Required:         true,
+						DefaultFunc:      schema.EnvDefaultFunc("AZDO_GITHUB_SERVICE_CONNECTION_PAT", nil),
+						Description:      "The GitHub personal access token which should be used.",
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
+						ValidateFunc:     validation.StringIsNotWhiteSpace,
 					},
 				},
 			},
@@ -68,6 +68,7 @@ func ResourceServiceEndpointGitHub() *schema.Resource {
 			ConflictsWith: []string{"auth_personal"},
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
 
 	return r
 }
@@ -113,7 +114,11 @@ func resourceServiceEndpointGitHubRead(d *schema.ResourceData, m interface{}) er
 func resourceServiceEndpointGitHubUpdate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	serviceEndpoint := expandServiceEndpointGitHub(d)
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
 	}
 
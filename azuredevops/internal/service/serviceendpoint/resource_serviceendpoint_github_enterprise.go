@@ -12,7 +12,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 // ResourceServiceEndpointGitHubEnterprise schema and implementation for github-enterprise service endpoint resource
@@ -28,7 +27,7 @@ func ResourceServiceEndpointGitHubEnterprise() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 	maps.Copy(r.Schema, map[string]*schema.Schema{
@@ -41,12 +40,13 @@ func ResourceServiceEndpointGitHubEnterprise() *schema.Resource {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"personal_access_token": {
-						Type:         schema.TypeString,
-						Required:     true,
-						Sensitive:    true,
-						DefaultFunc:  schema.EnvDefaultFunc("AZDO_GITHUB_ENTERPRISE_SERVICE_CONNECTION_PAT", nil),
-						Description:  "The GitHub personal access token which should be used.",
-						ValidateFunc: validation.StringIsNotWhiteSpace,
+						Type:             schema.TypeString,
+						Required:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
+						DefaultFunc:      schema.EnvDefaultFunc("AZDO_GITHUB_ENTERPRISE_SERVICE_CONNECTION_PAT", nil),
+						Description:      "The GitHub personal access token which should be used.",
+						ValidateFunc:     validation.StringIsNotWhiteSpace,
 					},
 				},
 			},
@@ -74,6 +74,8 @@ func ResourceServiceEndpointGitHubEnterprise() *schema.Resource {
 			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
+
 	return r
 }
 
@@ -118,7 +120,11 @@ func resourceServiceEndpointGitHubEnterpriseRead(d *schema.ResourceData, m inter
 func resourceServiceEndpointGitHubEnterpriseUpdate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	serviceEndpoint := expandServiceEndpointGitHubEnterprise(d)
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
 	}
 
@@ -163,6 +163,12 @@ func TestServiceEndpointGitHub_Update_DoesNotSwallowError(t *testing.T) {
 		EndpointId: ghTestServiceEndpoint.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(expectedArgs.Endpoint, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
@@ -10,7 +10,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 // ResourceServiceEndpointIncomingWebhook schema and implementation for incoming webhook service endpoint resource
@@ -26,7 +25,7 @@ func ResourceServiceEndpointIncomingWebhook() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 	maps.Copy(r.Schema, map[string]*schema.Schema{
@@ -38,11 +37,12 @@ func ResourceServiceEndpointIncomingWebhook() *schema.Resource {
 		},
 
 		"secret": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			DefaultFunc: schema.EnvDefaultFunc("AZDO_INCOMING_WEBHOOK_SERVICE_CONNECTION_SECRET", nil),
-			Description: "Optional secret for the webhook. WebHook service will use this secret to calculate the payload checksum.",
-			Sensitive:   true,
+			Type:             schema.TypeString,
+			Optional:         true,
+			DefaultFunc:      schema.EnvDefaultFunc("AZDO_INCOMING_WEBHOOK_SERVICE_CONNECTION_SECRET", nil),
+			Description:      "Optional secret for the webhook. WebHook service will use this secret to calculate the payload checksum.",
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
 		},
 
 		"http_header": {
@@ -52,6 +52,8 @@ func ResourceServiceEndpointIncomingWebhook() *schema.Resource {
 			Description: "Optional http header name on which checksum will be sent.",
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
+
 	return r
 }
 
@@ -96,7 +98,11 @@ func resourceServiceEndpointIncomingWebhookRead(d *schema.ResourceData, m interf
 func resourceServiceEndpointIncomingWebhookUpdate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	serviceEndpoint := expandServiceEndpointIncomingWebhook(d)
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
 	}
 
@@ -162,6 +162,12 @@ func TestServiceEndpointIncomingWebhook_Update_DoesNotSwallowError(t *testing.T)
 		EndpointId: incomingWebhookTestServiceEndpoint.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(expectedArgs.Endpoint, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
@@ -11,7 +11,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/validate"
 )
 
@@ -28,7 +27,7 @@ func ResourceServiceEndpointJFrogDistributionV2() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 	maps.Copy(r.Schema, map[string]*schema.Schema{
@@ -47,10 +46,11 @@ func ResourceServiceEndpointJFrogDistributionV2() *schema.Resource {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"token": {
-						Description: "The JFrog Artifactory access token.",
-						Type:        schema.TypeString,
-						Required:    true,
-						Sensitive:   true,
+						Description:      "The JFrog Artifactory access token.",
+						Type:             schema.TypeString,
+						Required:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
 					},
 				},
 			},
@@ -71,15 +71,17 @@ func ResourceServiceEndpointJFrogDistributionV2() *schema.Resource {
 						Sensitive:   true,
 					},
 					"password": {
-						Description: "The JFrog Artifactory password.",
-						Type:        schema.TypeString,
-						Required:    true,
-						Sensitive:   true,
+						Description:      "The JFrog Artifactory password.",
+						Type:             schema.TypeString,
+						Required:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
 					},
 				},
 			},
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
 
 	return r
 }
@@ -133,7 +135,11 @@ func resourceServiceEndpointJFrogDistributionV2Update(d *schema.ResourceData, m
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	if _, err = updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err = updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
 	}
 
@@ -7,13 +7,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 	"gopkg.in/yaml.v3"
 )
 
@@ -30,7 +30,7 @@ func ResourceServiceEndpointKubernetes() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 
@@ -46,7 +46,7 @@ func ResourceServiceEndpointKubernetes() *schema.Resource {
 			Type:         schema.TypeString,
 			Required:     true,
 			Description:  "Type of credentials to use",
-			ValidateFunc: validation.StringInSlice([]string{"AzureSubscription", "Kubeconfig", "ServiceAccount"}, false),
+			ValidateFunc: validation.StringInSlice([]string{"AzureSubscription", "WorkloadIdentityFederation", "Kubeconfig", "ServiceAccount"}, false),
 		},
 
 		"azure_subscription": {
@@ -102,6 +102,73 @@ func ResourceServiceEndpointKubernetes() *schema.Resource {
 						Default:     false,
 						Description: "Enable Cluster Admin",
 					},
+					"create_service_account": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						ForceNew:    true,
+						Default:     true,
+						Description: "Create a service account scoped to the namespace",
+					},
+				},
+			},
+		},
+
+		"azure_workload_identity": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "'WorkloadIdentityFederation'-type of configuration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"azurerm_service_endpoint_id": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Description:  "id of the AzureRM service endpoint using workload identity federation",
+						ValidateFunc: validation.IsUUID,
+					},
+					"subscription_id": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Description:  "id of azure subscription",
+						ValidateFunc: validation.IsUUID,
+					},
+					"subscription_name": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "name of azure subscription",
+					},
+					"resourcegroup_id": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Description:  "id of resourcegroup",
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+					"cluster_name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Description:  "name of aks-resource",
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+					"namespace": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "default",
+						Description: "accessed namespace",
+					},
+					"cluster_admin": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						ForceNew:    true,
+						Default:     false,
+						Description: "Enable Cluster Admin",
+					},
+					"create_service_account": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						ForceNew:    true,
+						Default:     true,
+						Description: "Create a service account scoped to the namespace",
+					},
 				},
 			},
 		},
@@ -115,11 +182,12 @@ func ResourceServiceEndpointKubernetes() *schema.Resource {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"kube_config": {
-						Type:        schema.TypeString,
-						Required:    true,
-						Sensitive:   true,
-						DefaultFunc: schema.EnvDefaultFunc("AZDO_KUBERNETES_SERVICE_CONNECTION_KUBECONFIG", nil),
-						Description: "Content of the kubeconfig file. The configuration information in your kubeconfig file allows Kubernetes clients to talk to your Kubernetes API servers. This file is used by kubectl and all supported Kubernetes clients.",
+						Type:             schema.TypeString,
+						Required:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
+						DefaultFunc:      schema.EnvDefaultFunc("AZDO_KUBERNETES_SERVICE_CONNECTION_KUBECONFIG", nil),
+						Description:      "Content of the kubeconfig file. The configuration information in your kubeconfig file allows Kubernetes clients to talk to your Kubernetes API servers. This file is used by kubectl and all supported Kubernetes clients.",
 					},
 					"cluster_context": {
 						Type:        schema.TypeString,
@@ -145,20 +213,22 @@ func ResourceServiceEndpointKubernetes() *schema.Resource {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"ca_cert": {
-						Type:         schema.TypeString,
-						Required:     true,
-						Sensitive:    true,
-						ValidateFunc: validation.StringIsNotEmpty,
-						DefaultFunc:  schema.EnvDefaultFunc("AZDO_KUBERNETES_SERVICE_CONNECTION_SERVICE_ACCOUNT_CERT", nil),
-						Description:  "Secret cert",
+						Type:             schema.TypeString,
+						Required:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
+						ValidateFunc:     validation.StringIsNotEmpty,
+						DefaultFunc:      schema.EnvDefaultFunc("AZDO_KUBERNETES_SERVICE_CONNECTION_SERVICE_ACCOUNT_CERT", nil),
+						Description:      "Secret cert",
 					},
 					"token": {
-						Type:         schema.TypeString,
-						Required:     true,
-						Sensitive:    true,
-						ValidateFunc: validation.StringIsNotEmpty,
-						DefaultFunc:  schema.EnvDefaultFunc("AZDO_KUBERNETES_SERVICE_CONNECTION_SERVICE_ACCOUNT_TOKEN", nil),
-						Description:  "Secret token",
+						Type:             schema.TypeString,
+						Required:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
+						ValidateFunc:     validation.StringIsNotEmpty,
+						DefaultFunc:      schema.EnvDefaultFunc("AZDO_KUBERNETES_SERVICE_CONNECTION_SERVICE_ACCOUNT_TOKEN", nil),
+						Description:      "Secret token",
 					},
 					"accept_untrusted_certs": {
 						Type:     schema.TypeBool,
@@ -169,6 +239,7 @@ func ResourceServiceEndpointKubernetes() *schema.Resource {
 			},
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
 
 	return r
 }
@@ -179,6 +250,9 @@ func resourceServiceEndpointKubernetesCreate(d *schema.ResourceData, m interface
 	if err != nil {
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
+	if err := expandServiceEndpointKubernetesWorkloadIdentityAuthorization(clients, d, serviceEndpoint); err != nil {
+		return err
+	}
 
 	serviceEndPoint, err := createServiceEndpoint(d, clients, serviceEndpoint)
 	if err != nil {
@@ -225,8 +299,15 @@ func resourceServiceEndpointKubernetesUpdate(d *schema.ResourceData, m interface
 	if err != nil {
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
+	if err := expandServiceEndpointKubernetesWorkloadIdentityAuthorization(clients, d, serviceEndpoint); err != nil {
+		return err
+	}
 
-	if _, err = updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err = updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("updating service endpoint in Azure DevOps: %+v", err)
 	}
 
@@ -270,6 +351,33 @@ func expandServiceEndpointKubernetes(d *schema.ResourceData) (*serviceendpoint.S
 			"namespace":             configuration["namespace"].(string),
 			"clusterAdmin":          strconv.FormatBool(configuration["cluster_admin"].(bool)),
 		}
+		if !configuration["create_service_account"].(bool) {
+			(*serviceEndpoint.Data)["createServiceAccount"] = "false"
+		}
+	case "WorkloadIdentityFederation":
+		configurationRaw := d.Get("azure_workload_identity").([]interface{})
+		if len(configurationRaw) == 0 || configurationRaw[0] == nil {
+			return nil, fmt.Errorf("`azure_workload_identity` must be set when `authorization_type` is `WorkloadIdentityFederation`")
+		}
+		configuration := configurationRaw[0].(map[string]interface{})
+
+		// The authorization is taken from the AzureRM service endpoint, see expandServiceEndpointKubernetesWorkloadIdentityAuthorization
+		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{},
+			Scheme:     converter.String("Kubernetes"),
+		}
+
+		clusterID := fmt.Sprintf("/subscriptions/%s/resourcegroups/%s/providers/Microsoft.ContainerService/managedClusters/%s", configuration["subscription_id"].(string), configuration["resourcegroup_id"].(string), configuration["cluster_name"].(string))
+		serviceEndpoint.Data = &map[string]string{
+			"authorizationType":         "WorkloadIdentityFederation",
+			"azureSubscriptionEndpoint": configuration["azurerm_service_endpoint_id"].(string),
+			"azureSubscriptionId":       configuration["subscription_id"].(string),
+			"azureSubscriptionName":     configuration["subscription_name"].(string),
+			"clusterId":                 clusterID,
+			"namespace":                 configuration["namespace"].(string),
+			"clusterAdmin":              strconv.FormatBool(configuration["cluster_admin"].(bool)),
+			"createServiceAccount":      strconv.FormatBool(configuration["create_service_account"].(bool)),
+		}
 	case "Kubeconfig":
 		configurationRaw := d.Get("kubeconfig").([]interface{})
 		configuration := configurationRaw[0].(map[string]interface{})
@@ -326,6 +434,48 @@ func expandServiceEndpointKubernetes(d *schema.ResourceData) (*serviceendpoint.S
 	return serviceEndpoint, nil
 }
 
+// expandServiceEndpointKubernetesWorkloadIdentityAuthorization sets the tenant and the cloud of the AzureRM service
+// endpoint whose workload identity is used to access the cluster
+func expandServiceEndpointKubernetesWorkloadIdentityAuthorization(clients *client.AggregatedClient, d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint) error {
+	if d.Get("authorization_type").(string) != "WorkloadIdentityFederation" {
+		return nil
+	}
+
+	azurermEndpointID, err := uuid.Parse((*serviceEndpoint.Data)["azureSubscriptionEndpoint"])
+	if err != nil {
+		return fmt.Errorf("Parsing AzureRM service endpoint ID: %+v", err)
+	}
+	projectID := d.Get("project_id").(string)
+	azurermEndpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+		EndpointId: &azurermEndpointID,
+		Project:    converter.String(projectID),
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf("Looking up AzureRM service endpoint given ID (%s) and project ID (%s): %+v", azurermEndpointID, projectID, err)
+	}
+	if azurermEndpoint == nil || azurermEndpoint.Id == nil {
+		return fmt.Errorf("AzureRM service endpoint with ID (%s) does not exist in project (%s)", azurermEndpointID, projectID)
+	}
+	if !strings.EqualFold(converter.ToString(azurermEndpoint.Type, ""), "azurerm") || azurermEndpoint.Authorization == nil ||
+		converter.ToString(azurermEndpoint.Authorization.Scheme, "") != string(WorkloadIdentityFederation) {
+		return fmt.Errorf("Service endpoint %s is not an AzureRM service endpoint using workload identity federation", azurermEndpointID)
+	}
+
+	environment := "AzureCloud"
+	if azurermEndpoint.Data != nil && (*azurermEndpoint.Data)["environment"] != "" {
+		environment = (*azurermEndpoint.Data)["environment"]
+	}
+	tenantID := ""
+	if azurermEndpoint.Authorization.Parameters != nil {
+		tenantID = (*azurermEndpoint.Authorization.Parameters)["tenantid"]
+	}
+	serviceEndpoint.Authorization.Parameters = &map[string]string{
+		"azureEnvironment": environment,
+		"azureTenantId":    tenantID,
+	}
+	return nil
+}
+
 // Convert AzDO data structure to internal Terraform data structure
 func flattenServiceEndpointKubernetes(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint) error {
 	if serviceEndpoint.Data != nil {
@@ -340,35 +490,50 @@ func flattenServiceEndpointKubernetes(d *schema.ResourceData, serviceEndpoint *s
 
 	switch (*serviceEndpoint.Data)["authorizationType"] {
 	case "AzureSubscription":
-		clusterIDSplit := strings.Split((*serviceEndpoint.Data)["clusterId"], "/")
-		var clusterNameIndex int
-		var resourceGroupIDIndex int
-		for k, v := range clusterIDSplit {
-			if v == "resourcegroups" {
-				resourceGroupIDIndex = k + 1
-			}
-			if v == "managedClusters" {
-				clusterNameIndex = k + 1
-			}
-		}
+		resourceGroupID, clusterName := parseKubernetesClusterID((*serviceEndpoint.Data)["clusterId"])
 		clusterAdmin, err := strconv.ParseBool((*serviceEndpoint.Data)["clusterAdmin"])
 		if err != nil {
 			return fmt.Errorf("Parsing `cluster_admin` value. Error: %+v", err)
 		}
+		createServiceAccount, err := parseKubernetesCreateServiceAccount(serviceEndpoint)
+		if err != nil {
+			return err
+		}
 		configItems := map[string]interface{}{
-			"azure_environment": (*serviceEndpoint.Authorization.Parameters)["azureEnvironment"],
-			"tenant_id":         (*serviceEndpoint.Authorization.Parameters)["azureTenantId"],
-			"subscription_id":   (*serviceEndpoint.Data)["azureSubscriptionId"],
-			"subscription_name": (*serviceEndpoint.Data)["azureSubscriptionName"],
-			"cluster_name":      clusterIDSplit[clusterNameIndex],
-			"resourcegroup_id":  clusterIDSplit[resourceGroupIDIndex],
-			"namespace":         (*serviceEndpoint.Data)["namespace"],
-			"cluster_admin":     clusterAdmin,
+			"azure_environment":      (*serviceEndpoint.Authorization.Parameters)["azureEnvironment"],
+			"tenant_id":              (*serviceEndpoint.Authorization.Parameters)["azureTenantId"],
+			"subscription_id":        (*serviceEndpoint.Data)["azureSubscriptionId"],
+			"subscription_name":      (*serviceEndpoint.Data)["azureSubscriptionName"],
+			"cluster_name":           clusterName,
+			"resourcegroup_id":       resourceGroupID,
+			"namespace":              (*serviceEndpoint.Data)["namespace"],
+			"cluster_admin":          clusterAdmin,
+			"create_service_account": createServiceAccount,
 		}
 		configItemList := make([]map[string]interface{}, 1)
 		configItemList[0] = configItems
 
 		d.Set("azure_subscription", configItemList)
+	case "WorkloadIdentityFederation":
+		resourceGroupID, clusterName := parseKubernetesClusterID((*serviceEndpoint.Data)["clusterId"])
+		clusterAdmin, err := strconv.ParseBool((*serviceEndpoint.Data)["clusterAdmin"])
+		if err != nil {
+			return fmt.Errorf("Parsing `cluster_admin` value. Error: %+v", err)
+		}
+		createServiceAccount, err := parseKubernetesCreateServiceAccount(serviceEndpoint)
+		if err != nil {
+			return err
+		}
+		d.Set("azure_workload_identity", []interface{}{map[string]interface{}{
+			"azurerm_service_endpoint_id": (*serviceEndpoint.Data)["azureSubscriptionEndpoint"],
+			"subscription_id":             (*serviceEndpoint.Data)["azureSubscriptionId"],
+			"subscription_name":           (*serviceEndpoint.Data)["azureSubscriptionName"],
+			"cluster_name":                clusterName,
+			"resourcegroup_id":            resourceGroupID,
+			"namespace":                   (*serviceEndpoint.Data)["namespace"],
+			"cluster_admin":               clusterAdmin,
+			"create_service_account":      createServiceAccount,
+		}})
 	case "Kubeconfig":
 		var kubeconfig map[string]interface{}
 
@@ -428,3 +593,35 @@ func flattenServiceEndpointKubernetes(d *schema.ResourceData, serviceEndpoint *s
 	}
 	return nil
 }
+
+// parseKubernetesClusterID returns the resource group and the name of the AKS cluster of a cluster ID
+func parseKubernetesClusterID(clusterID string) (string, string) {
+	clusterIDSplit := strings.Split(clusterID, "/")
+	var resourceGroupID, clusterName string
+	for k, v := range clusterIDSplit {
+		if k+1 >= len(clusterIDSplit) {
+			break
+		}
+		if strings.EqualFold(v, "resourcegroups") {
+			resourceGroupID = clusterIDSplit[k+1]
+		}
+		if strings.EqualFold(v, "managedClusters") {
+			clusterName = clusterIDSplit[k+1]
+		}
+	}
+	return resourceGroupID, clusterName
+}
+
+// parseKubernetesCreateServiceAccount reports whether a service account is created in the namespace, which is the
+// case unless it was turned off
+func parseKubernetesCreateServiceAccount(serviceEndpoint *serviceendpoint.ServiceEndpoint) (bool, error) {
+	v, ok := (*serviceEndpoint.Data)["createServiceAccount"]
+	if !ok || v == "" {
+		return true, nil
+	}
+	createServiceAccount, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("Parsing `create_service_account` value. Error: %+v", err)
+	}
+	return createServiceAccount, nil
+}
@@ -71,6 +71,44 @@ func createkubernetesTestServiceEndpointForAzureSubscription() *serviceendpoint.
 	return &serviceEndpoint
 }
 
+var kubernetesTestAzureRMServiceEndpointID = uuid.New()
+
+func createkubernetesTestServiceEndpointForWorkloadIdentity() *serviceendpoint.ServiceEndpoint {
+	serviceEndpoint := kubernetesTestServiceEndpoint
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Scheme:     converter.String("Kubernetes"),
+		Parameters: &map[string]string{},
+	}
+	serviceEndpoint.Data = &map[string]string{
+		"authorizationType":         "WorkloadIdentityFederation",
+		"azureSubscriptionEndpoint": kubernetesTestAzureRMServiceEndpointID.String(),
+		"azureSubscriptionId":       "kubernetes_TEST_subscription_id",
+		"azureSubscriptionName":     "kubernetes_TEST_subscription_name",
+		"clusterId":                 "/subscriptions/kubernetes_TEST_subscription_id/resourcegroups/kubernetes_TEST_resource_group_id/providers/Microsoft.ContainerService/managedClusters/kubernetes_TEST_cluster_name",
+		"namespace":                 "apps",
+		"clusterAdmin":              "false",
+		"createServiceAccount":      "false",
+	}
+
+	return &serviceEndpoint
+}
+
+func createkubernetesTestAzureRMServiceEndpoint(scheme string) *serviceendpoint.ServiceEndpoint {
+	return &serviceendpoint.ServiceEndpoint{
+		Id:   &kubernetesTestAzureRMServiceEndpointID,
+		Type: converter.String("azurerm"),
+		Authorization: &serviceendpoint.EndpointAuthorization{
+			Scheme: converter.String(scheme),
+			Parameters: &map[string]string{
+				"tenantid": "kubernetes_TEST_tenant_id",
+			},
+		},
+		Data: &map[string]string{
+			"environment": "AzureUSGovernment",
+		},
+	}
+}
+
 func createkubernetesTestServiceEndpointForKubeconfig() *serviceendpoint.ServiceEndpoint {
 	serviceEndpoint := kubernetesTestServiceEndpoint
 	serviceEndpoint.Authorization.Scheme = converter.String("Kubernetes")
@@ -137,6 +175,110 @@ func TestServiceEndpointKubernetesForAzureSubscriptionExpandFlattenRoundtrip(t *
 	require.Equal(t, kubernetesTestServiceEndpointProjectID, (*serviceEndpointAfterRoundTrip.ServiceEndpointProjectReferences)[0].ProjectReference.Id)
 }
 
+// verifies that turning off the service account creation survives the flatten/expand round trip
+func TestServiceEndpointKubernetesForAzureSubscriptionWithoutServiceAccountExpandFlattenRoundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointKubernetes().Schema, nil)
+	kubernetesTestServiceEndpointForAzureSubscription := createkubernetesTestServiceEndpointForAzureSubscription()
+	data := map[string]string{}
+	for k, v := range *kubernetesTestServiceEndpointForAzureSubscription.Data {
+		data[k] = v
+	}
+	data["createServiceAccount"] = "false"
+	kubernetesTestServiceEndpointForAzureSubscription.Data = &data
+	resourceData.Set("project_id", (*kubernetesTestServiceEndpointForAzureSubscription.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	doBaseFlattening(resourceData, kubernetesTestServiceEndpointForAzureSubscription)
+	flattenServiceEndpointKubernetes(resourceData, kubernetesTestServiceEndpointForAzureSubscription)
+
+	require.False(t, resourceData.Get("azure_subscription").(*schema.Set).List()[0].(map[string]interface{})["create_service_account"].(bool))
+
+	serviceEndpointAfterRoundTrip, err := expandServiceEndpointKubernetes(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, *kubernetesTestServiceEndpointForAzureSubscription, *serviceEndpointAfterRoundTrip)
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint for autorization type "WorkloadIdentityFederation"
+func TestServiceEndpointKubernetesForWorkloadIdentityExpandFlattenRoundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointKubernetes().Schema, nil)
+	kubernetesTestServiceEndpointForWorkloadIdentity := createkubernetesTestServiceEndpointForWorkloadIdentity()
+	resourceData.Set("project_id", (*kubernetesTestServiceEndpointForWorkloadIdentity.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	doBaseFlattening(resourceData, kubernetesTestServiceEndpointForWorkloadIdentity)
+	flattenServiceEndpointKubernetes(resourceData, kubernetesTestServiceEndpointForWorkloadIdentity)
+
+	serviceEndpointAfterRoundTrip, err := expandServiceEndpointKubernetes(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, *kubernetesTestServiceEndpointForWorkloadIdentity, *serviceEndpointAfterRoundTrip)
+}
+
+// verifies that the tenant and the cloud are taken from the AzureRM service endpoint on create
+func TestServiceEndpointKubernetesForWorkloadIdentityCreateUsesAzureRMServiceEndpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointKubernetes()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	kubernetesTestServiceEndpointForWorkloadIdentity := createkubernetesTestServiceEndpointForWorkloadIdentity()
+	resourceData.Set("project_id", kubernetesTestServiceEndpointProjectID.String())
+	doBaseFlattening(resourceData, kubernetesTestServiceEndpointForWorkloadIdentity)
+	flattenServiceEndpointKubernetes(resourceData, kubernetesTestServiceEndpointForWorkloadIdentity)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: &kubernetesTestAzureRMServiceEndpointID,
+			Project:    converter.String(kubernetesTestServiceEndpointProjectID.String()),
+		}).
+		Return(createkubernetesTestAzureRMServiceEndpoint("WorkloadIdentityFederation"), nil).
+		Times(1)
+
+	expectedEndpoint := createkubernetesTestServiceEndpointForWorkloadIdentity()
+	expectedEndpoint.Authorization.Parameters = &map[string]string{
+		"azureEnvironment": "AzureUSGovernment",
+		"azureTenantId":    "kubernetes_TEST_tenant_id",
+	}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.Ctx, serviceendpoint.CreateServiceEndpointArgs{Endpoint: expectedEndpoint}).
+		Return(nil, errors.New(errMsgCreateServiceEndpoint)).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), errMsgCreateServiceEndpoint)
+}
+
+// verifies that an AzureRM service endpoint that does not use workload identity federation is rejected
+func TestServiceEndpointKubernetesForWorkloadIdentityCreateRejectsServicePrincipal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointKubernetes()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	kubernetesTestServiceEndpointForWorkloadIdentity := createkubernetesTestServiceEndpointForWorkloadIdentity()
+	resourceData.Set("project_id", kubernetesTestServiceEndpointProjectID.String())
+	doBaseFlattening(resourceData, kubernetesTestServiceEndpointForWorkloadIdentity)
+	flattenServiceEndpointKubernetes(resourceData, kubernetesTestServiceEndpointForWorkloadIdentity)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(createkubernetesTestAzureRMServiceEndpoint("ServicePrincipal"), nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := r.Create(resourceData, clients)
+	require.ErrorContains(t, err, "is not an AzureRM service endpoint using workload identity federation")
+}
+
 // verifies that if an error is produced on create, the error is not swallowed
 func TestServiceEndpointKubernetesForAzureSubscriptionCreateDoesNotSwallowError(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -244,6 +386,12 @@ func TestServiceEndpointKubernetesForAzureSubscriptionUpdateDoesNotSwallowError(
 		EndpointId: kubernetesTestServiceEndpointForAzureSubscription.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(expectedArgs.Endpoint, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
@@ -379,6 +527,12 @@ func TestServiceEndpointKubernetesForKubeconfigUpdateDoesNotSwallowError(t *test
 		EndpointId: kubernetesTestServiceEndpointForKubeconfig.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(expectedArgs.Endpoint, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
@@ -515,6 +669,12 @@ func TestServiceEndpointKubernetesForServiceAccountUpdateDoesNotSwallowError(t *
 		EndpointId: kubernetesTestServiceEndpointForServiceAccount.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(expectedArgs.Endpoint, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
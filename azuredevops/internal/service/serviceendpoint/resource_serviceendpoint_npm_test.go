@@ -160,6 +160,12 @@ func TestServiceEndpointNpm_Update_DoesNotSwallowError(t *testing.T) {
 		EndpointId: npmTestServiceEndpoint.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(expectedArgs.Endpoint, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
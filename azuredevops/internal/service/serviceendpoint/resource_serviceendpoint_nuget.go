@@ -11,7 +11,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 func ResourceServiceEndpointNuGet() *schema.Resource {
@@ -26,7 +25,7 @@ func ResourceServiceEndpointNuGet() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 	maps.Copy(r.Schema, map[string]*schema.Schema{
@@ -37,20 +36,22 @@ func ResourceServiceEndpointNuGet() *schema.Resource {
 		},
 
 		"api_key": {
-			Type:          schema.TypeString,
-			Optional:      true,
-			Sensitive:     true,
-			ValidateFunc:  validation.StringIsNotEmpty,
-			ConflictsWith: []string{"personal_access_token", "username", "password"},
-			AtLeastOneOf:  []string{"api_key", "personal_access_token", "username", "password"},
+			Type:             schema.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			ValidateFunc:     validation.StringIsNotEmpty,
+			ConflictsWith:    []string{"personal_access_token", "username", "password"},
+			AtLeastOneOf:     []string{"api_key", "personal_access_token", "username", "password"},
 		},
 
 		"personal_access_token": {
-			Type:          schema.TypeString,
-			Optional:      true,
-			Sensitive:     true,
-			ValidateFunc:  validation.StringIsNotEmpty,
-			ConflictsWith: []string{"api_key", "username", "password"},
+			Type:             schema.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			ValidateFunc:     validation.StringIsNotEmpty,
+			ConflictsWith:    []string{"api_key", "username", "password"},
 		},
 
 		"username": {
@@ -62,14 +63,16 @@ func ResourceServiceEndpointNuGet() *schema.Resource {
 		},
 
 		"password": {
-			Type:          schema.TypeString,
-			Optional:      true,
-			Sensitive:     true,
-			ValidateFunc:  validation.StringIsNotEmpty,
-			ConflictsWith: []string{"personal_access_token", "api_key"},
-			RequiredWith:  []string{"username"},
+			Type:             schema.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			ValidateFunc:     validation.StringIsNotEmpty,
+			ConflictsWith:    []string{"personal_access_token", "api_key"},
+			RequiredWith:     []string{"username"},
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
 
 	return r
 }
@@ -115,7 +118,11 @@ func resourceServiceEndpointNuGetRead(d *schema.ResourceData, m interface{}) err
 func resourceServiceEndpointNuGetUpdate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	serviceEndpoint := expandServiceEndpointNuGet(d)
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
 	}
 
@@ -15,7 +15,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 func ResourceServiceEndpointOpenshift() *schema.Resource {
@@ -30,7 +29,7 @@ func ResourceServiceEndpointOpenshift() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 	maps.Copy(r.Schema, map[string]*schema.Schema{
@@ -67,10 +66,11 @@ func ResourceServiceEndpointOpenshift() *schema.Resource {
 						ValidateFunc: validation.StringIsNotWhiteSpace,
 					},
 					"password": {
-						Type:         schema.TypeString,
-						Required:     true,
-						Sensitive:    true,
-						ValidateFunc: validation.StringIsNotWhiteSpace,
+						Type:             schema.TypeString,
+						Required:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
+						ValidateFunc:     validation.StringIsNotWhiteSpace,
 					},
 				},
 			},
@@ -109,6 +109,8 @@ func ResourceServiceEndpointOpenshift() *schema.Resource {
 			},
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
+
 	return r
 }
 
@@ -155,7 +157,11 @@ func resourceServiceEndpointOpenshiftRead(ctx context.Context, d *schema.Resourc
 func resourceServiceEndpointOpenshiftUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clients := m.(*client.AggregatedClient)
 	serviceEndpoint := expandServiceEndpointOpenshift(d)
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return diag.Errorf(" Updating service endpoint in Azure DevOps: %+v", err)
 	}
 
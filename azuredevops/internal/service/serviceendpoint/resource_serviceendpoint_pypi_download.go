@@ -0,0 +1,150 @@
+package serviceendpoint
+
+import (
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceServiceEndpointPyPIDownload schema and implementation for Python package download service endpoint resource
+func ResourceServiceEndpointPyPIDownload() *schema.Resource {
+	r := &schema.Resource{
+		Create: resourceServiceEndpointPyPIDownloadCreate,
+		Read:   resourceServiceEndpointPyPIDownloadRead,
+		Update: resourceServiceEndpointPyPIDownloadUpdate,
+		Delete: resourceServiceEndpointPyPIDownloadDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Importer: importServiceEndpoint(),
+		Schema:   baseSchema(),
+	}
+
+	maps.Copy(r.Schema, map[string]*schema.Schema{
+		"url": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  "Url of the Python package index to download packages from",
+		},
+
+		"access_token": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			ValidateFunc:     validation.StringIsNotWhiteSpace,
+			ConflictsWith:    []string{"username", "password"},
+			AtLeastOneOf:     []string{"access_token", "username"},
+			Description:      "The access token for the Python package index",
+		},
+
+		"username": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.StringIsNotWhiteSpace,
+			ConflictsWith: []string{"access_token"},
+			RequiredWith:  []string{"password"},
+			Description:   "The username for the Python package index",
+		},
+
+		"password": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			ValidateFunc:     validation.StringIsNotWhiteSpace,
+			ConflictsWith:    []string{"access_token"},
+			RequiredWith:     []string{"username"},
+			Description:      "The password for the Python package index",
+		},
+	})
+	maps.Copy(r.Schema, credentialRotationSchema())
+
+	return r
+}
+
+func resourceServiceEndpointPyPIDownloadCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint := expandServiceEndpointPyPIDownload(d)
+	serviceEndPoint, err := createServiceEndpoint(d, clients, serviceEndpoint)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(serviceEndPoint.Id.String())
+	return resourceServiceEndpointPyPIDownloadRead(d, m)
+}
+
+func resourceServiceEndpointPyPIDownloadRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	getArgs, err := serviceEndpointGetArgs(d)
+	if err != nil {
+		return err
+	}
+
+	serviceEndpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, *getArgs)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("looking up service endpoint given ID (%v) and project ID (%v): %v", getArgs.EndpointId, getArgs.Project, err)
+	}
+	if serviceEndpoint == nil || serviceEndpoint.Id == nil {
+		return fmt.Errorf("unexpected nil service endpoint, ID: (%v), project ID: (%v)", getArgs.EndpointId, getArgs.Project)
+	}
+
+	if err = checkServiceConnection(serviceEndpoint); err != nil {
+		return err
+	}
+	flattenServiceEndpointPyPIDownload(d, serviceEndpoint)
+	return nil
+}
+
+func resourceServiceEndpointPyPIDownloadUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint := expandServiceEndpointPyPIDownload(d)
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
+	}
+
+	return resourceServiceEndpointPyPIDownloadRead(d, m)
+}
+
+func resourceServiceEndpointPyPIDownloadDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint := expandServiceEndpointPyPIDownload(d)
+	return deleteServiceEndpoint(clients, serviceEndpoint, d.Timeout(schema.TimeoutDelete))
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointPyPIDownload(d *schema.ResourceData) *serviceendpoint.ServiceEndpoint {
+	serviceEndpoint := doBaseExpansion(d)
+	serviceEndpoint.Type = converter.String("externalPythonDownloadFeed")
+	serviceEndpoint.Url = converter.String(d.Get("url").(string))
+	serviceEndpoint.Authorization = expandServiceEndpointPyPIAuthorization(d)
+	return serviceEndpoint
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenServiceEndpointPyPIDownload(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint) {
+	doBaseFlattening(d, serviceEndpoint)
+
+	d.Set("url", *serviceEndpoint.Url)
+	flattenServiceEndpointPyPIAuthorization(d, serviceEndpoint)
+}
@@ -0,0 +1,182 @@
+//go:build (all || resource_serviceendpoint_pypi_download) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_pypi_download
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	pypiDownloadTestServiceEndpointID          = uuid.New()
+	pypiDownloadRandomServiceEndpointProjectID = uuid.New()
+	pypiDownloadTestServiceEndpointProjectID   = &pypiDownloadRandomServiceEndpointProjectID
+)
+
+var pypiDownloadTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"username": "UNIT_TEST_USERNAME",
+			"password": "UNIT_TEST_PASSWORD",
+		},
+		Scheme: converter.String("UsernamePassword"),
+	},
+	Id:          &pypiDownloadTestServiceEndpointID,
+	Name:        converter.String("UNIT_TEST_CONN_NAME"),
+	Owner:       converter.String("library"),
+	Type:        converter.String("externalPythonDownloadFeed"),
+	Url:         converter.String("https://pypi.org/simple/"),
+	Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: pypiDownloadTestServiceEndpointProjectID,
+			},
+			Name:        converter.String("UNIT_TEST_CONN_NAME"),
+			Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestServiceEndpointPyPIDownload_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointPyPIDownload().Schema, nil)
+	resourceData.Set("project_id", (*pypiDownloadTestServiceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	resourceData.Set("password", "UNIT_TEST_PASSWORD")
+	flattenServiceEndpointPyPIDownload(resourceData, &pypiDownloadTestServiceEndpoint)
+
+	serviceEndpointAfterRoundTrip := expandServiceEndpointPyPIDownload(resourceData)
+
+	require.Equal(t, pypiDownloadTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, pypiDownloadTestServiceEndpointProjectID, (*serviceEndpointAfterRoundTrip.ServiceEndpointProjectReferences)[0].ProjectReference.Id)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointPyPIDownload_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointPyPIDownload()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("project_id", (*pypiDownloadTestServiceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	resourceData.Set("password", "UNIT_TEST_PASSWORD")
+	flattenServiceEndpointPyPIDownload(resourceData, &pypiDownloadTestServiceEndpoint)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &pypiDownloadTestServiceEndpoint}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestServiceEndpointPyPIDownload_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointPyPIDownload()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("project_id", (*pypiDownloadTestServiceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	resourceData.Set("password", "UNIT_TEST_PASSWORD")
+	flattenServiceEndpointPyPIDownload(resourceData, &pypiDownloadTestServiceEndpoint)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+		EndpointId: pypiDownloadTestServiceEndpoint.Id,
+		Project:    converter.String(pypiDownloadTestServiceEndpointProjectID.String()),
+	}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestServiceEndpointPyPIDownload_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointPyPIDownload()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("project_id", (*pypiDownloadTestServiceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	resourceData.Set("password", "UNIT_TEST_PASSWORD")
+	flattenServiceEndpointPyPIDownload(resourceData, &pypiDownloadTestServiceEndpoint)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{
+		EndpointId: pypiDownloadTestServiceEndpoint.Id,
+		ProjectIds: &[]string{
+			pypiDownloadTestServiceEndpointProjectID.String(),
+		},
+	}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestServiceEndpointPyPIDownload_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointPyPIDownload()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("project_id", (*pypiDownloadTestServiceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	resourceData.Set("password", "UNIT_TEST_PASSWORD")
+	flattenServiceEndpointPyPIDownload(resourceData, &pypiDownloadTestServiceEndpoint)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &pypiDownloadTestServiceEndpoint,
+		EndpointId: pypiDownloadTestServiceEndpoint.Id,
+	}
+
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(expectedArgs.Endpoint, nil).
+		Times(1)
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
@@ -0,0 +1,199 @@
+package serviceendpoint
+
+import (
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceServiceEndpointPyPIUpload schema and implementation for Python package upload service endpoint resource
+func ResourceServiceEndpointPyPIUpload() *schema.Resource {
+	r := &schema.Resource{
+		Create: resourceServiceEndpointPyPIUploadCreate,
+		Read:   resourceServiceEndpointPyPIUploadRead,
+		Update: resourceServiceEndpointPyPIUploadUpdate,
+		Delete: resourceServiceEndpointPyPIUploadDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Importer: importServiceEndpoint(),
+		Schema:   baseSchema(),
+	}
+
+	maps.Copy(r.Schema, map[string]*schema.Schema{
+		"url": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  "Url of the Python repository to upload packages to",
+		},
+
+		"repository_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+			Description:  "Name of the Python repository used by twine",
+		},
+
+		"access_token": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			ValidateFunc:     validation.StringIsNotWhiteSpace,
+			ConflictsWith:    []string{"username", "password"},
+			AtLeastOneOf:     []string{"access_token", "username"},
+			Description:      "The access token for the Python repository",
+		},
+
+		"username": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.StringIsNotWhiteSpace,
+			ConflictsWith: []string{"access_token"},
+			RequiredWith:  []string{"password"},
+			Description:   "The username for the Python repository",
+		},
+
+		"password": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			ValidateFunc:     validation.StringIsNotWhiteSpace,
+			ConflictsWith:    []string{"access_token"},
+			RequiredWith:     []string{"username"},
+			Description:      "The password for the Python repository",
+		},
+	})
+	maps.Copy(r.Schema, credentialRotationSchema())
+
+	return r
+}
+
+func resourceServiceEndpointPyPIUploadCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint := expandServiceEndpointPyPIUpload(d)
+	serviceEndPoint, err := createServiceEndpoint(d, clients, serviceEndpoint)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(serviceEndPoint.Id.String())
+	return resourceServiceEndpointPyPIUploadRead(d, m)
+}
+
+func resourceServiceEndpointPyPIUploadRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	getArgs, err := serviceEndpointGetArgs(d)
+	if err != nil {
+		return err
+	}
+
+	serviceEndpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, *getArgs)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("looking up service endpoint given ID (%v) and project ID (%v): %v", getArgs.EndpointId, getArgs.Project, err)
+	}
+	if serviceEndpoint == nil || serviceEndpoint.Id == nil {
+		return fmt.Errorf("unexpected nil service endpoint, ID: (%v), project ID: (%v)", getArgs.EndpointId, getArgs.Project)
+	}
+
+	if err = checkServiceConnection(serviceEndpoint); err != nil {
+		return err
+	}
+	flattenServiceEndpointPyPIUpload(d, serviceEndpoint)
+	return nil
+}
+
+func resourceServiceEndpointPyPIUploadUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint := expandServiceEndpointPyPIUpload(d)
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
+	}
+
+	return resourceServiceEndpointPyPIUploadRead(d, m)
+}
+
+func resourceServiceEndpointPyPIUploadDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint := expandServiceEndpointPyPIUpload(d)
+	return deleteServiceEndpoint(clients, serviceEndpoint, d.Timeout(schema.TimeoutDelete))
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointPyPIUpload(d *schema.ResourceData) *serviceendpoint.ServiceEndpoint {
+	serviceEndpoint := doBaseExpansion(d)
+	serviceEndpoint.Type = converter.String("externalPythonUploadFeed")
+	serviceEndpoint.Url = converter.String(d.Get("url").(string))
+	serviceEndpoint.Data = &map[string]string{
+		"EndpointName": d.Get("repository_name").(string),
+	}
+	serviceEndpoint.Authorization = expandServiceEndpointPyPIAuthorization(d)
+	return serviceEndpoint
+}
+
+// expandServiceEndpointPyPIAuthorization builds the token or username/password authorization shared by the Python package endpoints
+func expandServiceEndpointPyPIAuthorization(d *schema.ResourceData) *serviceendpoint.EndpointAuthorization {
+	if username := d.Get("username").(string); username != "" {
+		return &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{
+				"username": username,
+				"password": d.Get("password").(string),
+			},
+			Scheme: converter.String("UsernamePassword"),
+		}
+	}
+	return &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"apitoken": d.Get("access_token").(string),
+		},
+		Scheme: converter.String("Token"),
+	}
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenServiceEndpointPyPIUpload(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint) {
+	doBaseFlattening(d, serviceEndpoint)
+
+	d.Set("url", *serviceEndpoint.Url)
+	if serviceEndpoint.Data != nil {
+		d.Set("repository_name", (*serviceEndpoint.Data)["EndpointName"])
+	}
+	flattenServiceEndpointPyPIAuthorization(d, serviceEndpoint)
+}
+
+// flattenServiceEndpointPyPIAuthorization sets the authorization fields shared by the Python package endpoints
+func flattenServiceEndpointPyPIAuthorization(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint) {
+	if serviceEndpoint.Authorization == nil || serviceEndpoint.Authorization.Scheme == nil {
+		return
+	}
+
+	switch *serviceEndpoint.Authorization.Scheme {
+	case "Token":
+		d.Set("access_token", d.Get("access_token").(string))
+	case "UsernamePassword":
+		if serviceEndpoint.Authorization.Parameters != nil {
+			d.Set("username", (*serviceEndpoint.Authorization.Parameters)["username"])
+		}
+		d.Set("password", d.Get("password").(string))
+	}
+}
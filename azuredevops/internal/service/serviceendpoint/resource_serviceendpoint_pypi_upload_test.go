@@ -0,0 +1,238 @@
+//go:build (all || resource_serviceendpoint_pypi_upload) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_pypi_upload
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	pypiUploadTestServiceEndpointID          = uuid.New()
+	pypiUploadRandomServiceEndpointProjectID = uuid.New()
+	pypiUploadTestServiceEndpointProjectID   = &pypiUploadRandomServiceEndpointProjectID
+)
+
+var pypiUploadTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"apitoken": "UNIT_TEST_TOKEN",
+		},
+		Scheme: converter.String("Token"),
+	},
+	Id:          &pypiUploadTestServiceEndpointID,
+	Name:        converter.String("UNIT_TEST_CONN_NAME"),
+	Owner:       converter.String("library"),
+	Type:        converter.String("externalPythonUploadFeed"),
+	Url:         converter.String("https://upload.pypi.org/legacy/"),
+	Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+	Data: &map[string]string{
+		"EndpointName": "pypi",
+	},
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: pypiUploadTestServiceEndpointProjectID,
+			},
+			Name:        converter.String("UNIT_TEST_CONN_NAME"),
+			Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestServiceEndpointPyPIUpload_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointPyPIUpload().Schema, nil)
+	resourceData.Set("project_id", (*pypiUploadTestServiceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	resourceData.Set("access_token", "UNIT_TEST_TOKEN")
+	flattenServiceEndpointPyPIUpload(resourceData, &pypiUploadTestServiceEndpoint)
+
+	serviceEndpointAfterRoundTrip := expandServiceEndpointPyPIUpload(resourceData)
+
+	require.Equal(t, pypiUploadTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, pypiUploadTestServiceEndpointProjectID, (*serviceEndpointAfterRoundTrip.ServiceEndpointProjectReferences)[0].ProjectReference.Id)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointPyPIUpload_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointPyPIUpload()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("project_id", (*pypiUploadTestServiceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	resourceData.Set("access_token", "UNIT_TEST_TOKEN")
+	flattenServiceEndpointPyPIUpload(resourceData, &pypiUploadTestServiceEndpoint)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &pypiUploadTestServiceEndpoint}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestServiceEndpointPyPIUpload_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointPyPIUpload()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("project_id", (*pypiUploadTestServiceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	resourceData.Set("access_token", "UNIT_TEST_TOKEN")
+	flattenServiceEndpointPyPIUpload(resourceData, &pypiUploadTestServiceEndpoint)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+		EndpointId: pypiUploadTestServiceEndpoint.Id,
+		Project:    converter.String(pypiUploadTestServiceEndpointProjectID.String()),
+	}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestServiceEndpointPyPIUpload_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointPyPIUpload()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("project_id", (*pypiUploadTestServiceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	resourceData.Set("access_token", "UNIT_TEST_TOKEN")
+	flattenServiceEndpointPyPIUpload(resourceData, &pypiUploadTestServiceEndpoint)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{
+		EndpointId: pypiUploadTestServiceEndpoint.Id,
+		ProjectIds: &[]string{
+			pypiUploadTestServiceEndpointProjectID.String(),
+		},
+	}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestServiceEndpointPyPIUpload_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointPyPIUpload()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("project_id", (*pypiUploadTestServiceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	resourceData.Set("access_token", "UNIT_TEST_TOKEN")
+	flattenServiceEndpointPyPIUpload(resourceData, &pypiUploadTestServiceEndpoint)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &pypiUploadTestServiceEndpoint,
+		EndpointId: pypiUploadTestServiceEndpoint.Id,
+	}
+
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(expectedArgs.Endpoint, nil).
+		Times(1)
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+// verifies that bumping the secret version validates the rotated credentials before they are sent and keeps the
+// previous version on failure
+func TestServiceEndpointPyPIUpload_Update_ValidatesRotatedCredentials(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointPyPIUpload()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("project_id", pypiUploadTestServiceEndpointProjectID.String())
+	resourceData.Set("access_token", "UNIT_TEST_TOKEN")
+	resourceData.Set("secret_version", "1")
+	flattenServiceEndpointPyPIUpload(resourceData, &pypiUploadTestServiceEndpoint)
+	state := resourceData.State()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id":            pypiUploadTestServiceEndpointProjectID.String(),
+		"service_endpoint_name": *pypiUploadTestServiceEndpoint.Name,
+		"description":           *pypiUploadTestServiceEndpoint.Description,
+		"url":                   *pypiUploadTestServiceEndpoint.Url,
+		"repository_name":       "pypi",
+		"access_token":          "UNIT_TEST_ROTATED_TOKEN",
+		"secret_version":        "2",
+		"validate_rotation":     true,
+	})
+	diff, err := r.Diff(context.Background(), state, config, nil)
+	require.Nil(t, err)
+	require.False(t, diff.RequiresNew())
+
+	resourceData, err = schema.InternalMap(r.Schema).Data(state, diff)
+	require.Nil(t, err)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		ExecuteServiceEndpointRequest(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args serviceendpoint.ExecuteServiceEndpointRequestArgs) (*serviceendpoint.ServiceEndpointRequestResult, error) {
+			require.Equal(t, "UNIT_TEST_ROTATED_TOKEN", (*args.ServiceEndpointRequest.ServiceEndpointDetails.Authorization.Parameters)["apitoken"])
+			return nil, errors.New("ExecuteServiceEndpointRequest() Failed")
+		}).
+		Times(1)
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err = r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "Validating the rotated credentials")
+	require.Equal(t, "1", resourceData.State().Attributes["secret_version"])
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 // ResourceServiceEndpointRunPipeline schema and implementation for Azure DevOps service endpoint resource
@@ -27,7 +26,7 @@ func ResourceServiceEndpointRunPipeline() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 
@@ -46,17 +45,19 @@ func ResourceServiceEndpointRunPipeline() *schema.Resource {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"personal_access_token": {
-						Type:         schema.TypeString,
-						Required:     true,
-						DefaultFunc:  schema.EnvDefaultFunc("AZDO_PERSONAL_ACCESS_TOKEN", nil),
-						Description:  "The Azure DevOps personal access token which should be used.",
-						Sensitive:    true,
-						ValidateFunc: validation.StringIsNotWhiteSpace,
+						Type:             schema.TypeString,
+						Required:         true,
+						DefaultFunc:      schema.EnvDefaultFunc("AZDO_PERSONAL_ACCESS_TOKEN", nil),
+						Description:      "The Azure DevOps personal access token which should be used.",
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
+						ValidateFunc:     validation.StringIsNotWhiteSpace,
 					},
 				},
 			},
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
 
 	return r
 }
@@ -102,7 +103,11 @@ func resourceServiceEndpointRunPipelineRead(d *schema.ResourceData, m interface{
 func resourceServiceEndpointRunPipelineUpdate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	serviceEndpoint := expandServiceEndpointRunPipeline(d)
-	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
 	}
 
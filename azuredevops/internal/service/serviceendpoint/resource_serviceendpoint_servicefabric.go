@@ -12,7 +12,6 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
-	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 // ResourceServiceEndpointServiceFabric schema and implementation for ServiceFabric service endpoint resource
@@ -28,7 +27,7 @@ func ResourceServiceEndpointServiceFabric() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Importer: importServiceEndpoint(),
 		Schema:   baseSchema(),
 	}
 
@@ -68,18 +67,20 @@ func ResourceServiceEndpointServiceFabric() *schema.Resource {
 						ConflictsWith: []string{"certificate.0.server_certificate_thumbprint"},
 					},
 					"client_certificate": {
-						Type:         schema.TypeString,
-						Required:     true,
-						Description:  "Base64 encoding of the cluster's client certificate file.",
-						Sensitive:    true,
-						ValidateFunc: validation.StringIsNotEmpty,
+						Type:             schema.TypeString,
+						Required:         true,
+						Description:      "Base64 encoding of the cluster's client certificate file.",
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
+						ValidateFunc:     validation.StringIsNotEmpty,
 					},
 					"client_certificate_password": {
-						Type:         schema.TypeString,
-						Optional:     true,
-						Description:  "Password for the certificate.",
-						Sensitive:    true,
-						ValidateFunc: validation.StringIsNotEmpty,
+						Type:             schema.TypeString,
+						Optional:         true,
+						Description:      "Password for the certificate.",
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
+						ValidateFunc:     validation.StringIsNotEmpty,
 					},
 				},
 			},
@@ -121,11 +122,12 @@ func ResourceServiceEndpointServiceFabric() *schema.Resource {
 						Description:  "Specify an Azure Active Directory account.",
 					},
 					"password": {
-						Type:         schema.TypeString,
-						Required:     true,
-						Description:  "Password for the Azure Active Directory account.",
-						Sensitive:    true,
-						ValidateFunc: validation.StringIsNotEmpty,
+						Type:             schema.TypeString,
+						Required:         true,
+						Description:      "Password for the Azure Active Directory account.",
+						Sensitive:        true,
+						DiffSuppressFunc: suppressAdoptedCredential,
+						ValidateFunc:     validation.StringIsNotEmpty,
 					},
 				},
 			},
@@ -155,6 +157,7 @@ func ResourceServiceEndpointServiceFabric() *schema.Resource {
 			ConflictsWith: []string{"certificate", "azure_active_directory"},
 		},
 	})
+	maps.Copy(r.Schema, credentialRotationSchema())
 
 	return r
 }
@@ -208,7 +211,11 @@ func resourceServiceEndpointServiceFabricUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
-	if _, err = updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err = updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
 		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
 	}
 
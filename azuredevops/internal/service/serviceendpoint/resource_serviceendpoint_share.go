@@ -0,0 +1,187 @@
+package serviceendpoint
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceServiceEndpointShare schema and implementation for sharing an existing service endpoint with another project
+func ResourceServiceEndpointShare() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServiceEndpointShareCreate,
+		ReadContext:   resourceServiceEndpointShareRead,
+		DeleteContext: resourceServiceEndpointShareDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importServiceEndpointShare,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"service_endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"target_project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+		},
+	}
+}
+
+func resourceServiceEndpointShareCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	targetProjectID := d.Get("target_project_id").(string)
+	if strings.EqualFold(projectID, targetProjectID) {
+		return diag.Errorf("The service endpoint cannot be shared with the project it belongs to. Project ID: %s", projectID)
+	}
+
+	endpointID, err := uuid.Parse(d.Get("service_endpoint_id").(string))
+	if err != nil {
+		return diag.Errorf("Parsing service endpoint ID: %+v", err)
+	}
+
+	endpoint, err := getProjectServiceEndpoint(clients, &endpointID, projectID)
+	if err != nil {
+		return diag.Errorf("Looking up service endpoint given ID (%s) and project ID (%s): %+v", endpointID, projectID, err)
+	}
+	if endpoint == nil {
+		return diag.Errorf("Service endpoint with ID (%s) does not exist in project (%s)", endpointID, projectID)
+	}
+	if findServiceEndpointProjectReference(endpoint, targetProjectID) != nil {
+		return diag.Errorf("The service endpoint %s is already shared with project %s. Import the existing share with `terraform import`.", endpointID, targetProjectID)
+	}
+
+	name := converter.ToString(endpoint.Name, "")
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	}
+	description := converter.ToString(endpoint.Description, "")
+	if v, ok := d.GetOk("description"); ok {
+		description = v.(string)
+	}
+	references, err := expandServiceEndpointProjectReferences([]string{targetProjectID}, name, description)
+	if err != nil {
+		return diag.Errorf("Parsing target project ID: %+v", err)
+	}
+
+	if err := shareServiceEndpoint(clients, &endpointID, references); err != nil {
+		return diag.Errorf("Sharing service endpoint %s with project %s: %+v", endpointID, targetProjectID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", projectID, endpointID.String(), targetProjectID))
+	return resourceServiceEndpointShareRead(ctx, d, m)
+}
+
+func resourceServiceEndpointShareRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	targetProjectID := d.Get("target_project_id").(string)
+
+	endpointID, err := uuid.Parse(d.Get("service_endpoint_id").(string))
+	if err != nil {
+		return diag.Errorf("Parsing service endpoint ID: %+v", err)
+	}
+
+	endpoint, err := getProjectServiceEndpoint(clients, &endpointID, projectID)
+	if err != nil {
+		return diag.Errorf("Looking up service endpoint given ID (%s) and project ID (%s): %+v", endpointID, projectID, err)
+	}
+	if endpoint == nil {
+		d.SetId("")
+		return nil
+	}
+
+	reference := findServiceEndpointProjectReference(endpoint, targetProjectID)
+	if reference == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", converter.ToString(reference.Name, ""))
+	d.Set("description", converter.ToString(reference.Description, ""))
+	return nil
+}
+
+func resourceServiceEndpointShareDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	targetProjectID := d.Get("target_project_id").(string)
+
+	endpointID, err := uuid.Parse(d.Get("service_endpoint_id").(string))
+	if err != nil {
+		return diag.Errorf("Parsing service endpoint ID: %+v", err)
+	}
+
+	// Deleting the endpoint from the last project it is referenced by deletes the endpoint itself. Only
+	// unshare if the endpoint still belongs to the owning project.
+	endpoint, err := getProjectServiceEndpoint(clients, &endpointID, projectID)
+	if err != nil {
+		return diag.Errorf("Looking up service endpoint given ID (%s) and project ID (%s): %+v", endpointID, projectID, err)
+	}
+	if endpoint == nil || findServiceEndpointProjectReference(endpoint, targetProjectID) == nil {
+		return nil
+	}
+	if findServiceEndpointProjectReference(endpoint, projectID) == nil {
+		return diag.Errorf("The service endpoint %s is no longer referenced by project %s, unsharing it from project %s would delete it", endpointID, projectID, targetProjectID)
+	}
+
+	if err := unshareServiceEndpoint(clients, &endpointID, []string{targetProjectID}); err != nil {
+		return diag.Errorf("Unsharing service endpoint %s from project %s: %+v", endpointID, targetProjectID, err)
+	}
+	return nil
+}
+
+func importServiceEndpointShare(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Unexpected format of ID (%s), expected <projectID>/<serviceEndpointID>/<targetProjectID>", d.Id())
+	}
+	for _, part := range parts {
+		if _, err := uuid.Parse(part); err != nil {
+			return nil, fmt.Errorf("Unexpected format of ID (%s), %s is not a UUID", d.Id(), part)
+		}
+	}
+
+	d.Set("project_id", parts[0])
+	d.Set("service_endpoint_id", parts[1])
+	d.Set("target_project_id", parts[2])
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,184 @@
+//go:build (all || resource_serviceendpoint_share) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_share
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func getShareTestServiceEndpoint(endpointID uuid.UUID, projectIDs ...uuid.UUID) *serviceendpoint.ServiceEndpoint {
+	references := make([]serviceendpoint.ServiceEndpointProjectReference, 0, len(projectIDs))
+	for i := range projectIDs {
+		references = append(references, serviceendpoint.ServiceEndpointProjectReference{
+			ProjectReference: &serviceendpoint.ProjectReference{Id: &projectIDs[i]},
+			Name:             converter.String("shared-" + projectIDs[i].String()),
+			Description:      converter.String("description"),
+		})
+	}
+	return &serviceendpoint.ServiceEndpoint{
+		Id:                               &endpointID,
+		Name:                             converter.String("endpoint"),
+		Description:                      converter.String("description"),
+		ServiceEndpointProjectReferences: &references,
+	}
+}
+
+func TestServiceEndpointShare_Create_SharesWithTargetProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: endpointClient, Ctx: context.Background()}
+
+	endpointID := uuid.New()
+	projectID := uuid.New()
+	targetProjectID := uuid.New()
+
+	getArgs := serviceendpoint.GetServiceEndpointDetailsArgs{EndpointId: &endpointID, Project: converter.String(projectID.String())}
+	lookup := endpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, getArgs).
+		Return(getShareTestServiceEndpoint(endpointID, projectID), nil).
+		Times(1)
+	share := endpointClient.
+		EXPECT().
+		ShareServiceEndpoint(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args serviceendpoint.ShareServiceEndpointArgs) error {
+			require.Equal(t, endpointID, *args.EndpointId)
+			require.Len(t, *args.EndpointProjectReferences, 1)
+			reference := (*args.EndpointProjectReferences)[0]
+			require.Equal(t, targetProjectID, *reference.ProjectReference.Id)
+			require.Equal(t, "spoke", *reference.Name)
+			require.Equal(t, "description", *reference.Description)
+			return nil
+		}).
+		After(lookup).
+		Times(1)
+	shared := getShareTestServiceEndpoint(endpointID, projectID, targetProjectID)
+	(*shared.ServiceEndpointProjectReferences)[1].Name = converter.String("spoke")
+	endpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, getArgs).
+		Return(shared, nil).
+		After(share).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointShare().Schema, map[string]interface{}{
+		"project_id":          projectID.String(),
+		"service_endpoint_id": endpointID.String(),
+		"target_project_id":   targetProjectID.String(),
+		"name":                "spoke",
+	})
+	diags := resourceServiceEndpointShareCreate(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, projectID.String()+"/"+endpointID.String()+"/"+targetProjectID.String(), resourceData.Id())
+	require.Equal(t, "spoke", resourceData.Get("name"))
+	require.Equal(t, "description", resourceData.Get("description"))
+}
+
+func TestServiceEndpointShare_Create_RejectsOwningProject(t *testing.T) {
+	projectID := uuid.New().String()
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointShare().Schema, map[string]interface{}{
+		"project_id":          projectID,
+		"service_endpoint_id": uuid.New().String(),
+		"target_project_id":   projectID,
+	})
+	diags := resourceServiceEndpointShareCreate(context.Background(), resourceData, &client.AggregatedClient{})
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "cannot be shared with the project it belongs to")
+}
+
+func TestServiceEndpointShare_Read_RemovesUnsharedEndpointFromState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: endpointClient, Ctx: context.Background()}
+
+	endpointID := uuid.New()
+	projectID := uuid.New()
+	endpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(getShareTestServiceEndpoint(endpointID, projectID), nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointShare().Schema, map[string]interface{}{
+		"project_id":          projectID.String(),
+		"service_endpoint_id": endpointID.String(),
+		"target_project_id":   uuid.New().String(),
+	})
+	resourceData.SetId("share")
+	diags := resourceServiceEndpointShareRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestServiceEndpointShare_Delete_UnsharesTargetProjectOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: endpointClient, Ctx: context.Background()}
+
+	endpointID := uuid.New()
+	projectID := uuid.New()
+	targetProjectID := uuid.New()
+	endpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(getShareTestServiceEndpoint(endpointID, projectID, targetProjectID), nil).
+		Times(1)
+	endpointClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+			EndpointId: &endpointID,
+			ProjectIds: &[]string{targetProjectID.String()},
+		}).
+		Return(nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointShare().Schema, map[string]interface{}{
+		"project_id":          projectID.String(),
+		"service_endpoint_id": endpointID.String(),
+		"target_project_id":   targetProjectID.String(),
+	})
+	diags := resourceServiceEndpointShareDelete(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError(), "%v", diags)
+}
+
+func TestServiceEndpointShare_Delete_IgnoresDeletedEndpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: endpointClient, Ctx: context.Background()}
+
+	endpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointShare().Schema, map[string]interface{}{
+		"project_id":          uuid.New().String(),
+		"service_endpoint_id": uuid.New().String(),
+		"target_project_id":   uuid.New().String(),
+	})
+	diags := resourceServiceEndpointShareDelete(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError(), "%v", diags)
+}
@@ -160,6 +160,12 @@ func TestServiceEndpointSonarQube_Update_DoesNotSwallowError(t *testing.T) {
 		EndpointId: sonarQubeTestServiceEndpoint.Id,
 	}
 
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(expectedArgs.Endpoint, nil).
+		Times(1)
+
 	buildClient.
 		EXPECT().
 		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
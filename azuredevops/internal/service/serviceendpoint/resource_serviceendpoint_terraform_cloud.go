@@ -0,0 +1,149 @@
+package serviceendpoint
+
+import (
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceServiceEndpointTerraformCloud schema and implementation for Terraform Cloud/Enterprise service endpoint resource
+func ResourceServiceEndpointTerraformCloud() *schema.Resource {
+	r := &schema.Resource{
+		Create: resourceServiceEndpointTerraformCloudCreate,
+		Read:   resourceServiceEndpointTerraformCloudRead,
+		Update: resourceServiceEndpointTerraformCloudUpdate,
+		Delete: resourceServiceEndpointTerraformCloudDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Importer: importServiceEndpoint(),
+		Schema:   baseSchema(),
+	}
+
+	maps.Copy(r.Schema, map[string]*schema.Schema{
+		"url": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "https://app.terraform.io",
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  "Url of Terraform Cloud or of the Terraform Enterprise instance",
+		},
+
+		"organization": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+			Description:  "The Terraform Cloud/Enterprise organization",
+		},
+
+		"api_token": {
+			Type:             schema.TypeString,
+			Required:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressAdoptedCredential,
+			ValidateFunc:     validation.StringIsNotWhiteSpace,
+			Description:      "The user, team or organization API token",
+		},
+	})
+	maps.Copy(r.Schema, credentialRotationSchema())
+
+	return r
+}
+
+func resourceServiceEndpointTerraformCloudCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint := expandServiceEndpointTerraformCloud(d)
+	serviceEndPoint, err := createServiceEndpoint(d, clients, serviceEndpoint)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(serviceEndPoint.Id.String())
+	return resourceServiceEndpointTerraformCloudRead(d, m)
+}
+
+func resourceServiceEndpointTerraformCloudRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	getArgs, err := serviceEndpointGetArgs(d)
+	if err != nil {
+		return err
+	}
+
+	serviceEndpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, *getArgs)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("looking up service endpoint given ID (%v) and project ID (%v): %v", getArgs.EndpointId, getArgs.Project, err)
+	}
+	if serviceEndpoint == nil || serviceEndpoint.Id == nil {
+		return fmt.Errorf("unexpected nil service endpoint, ID: (%v), project ID: (%v)", getArgs.EndpointId, getArgs.Project)
+	}
+
+	if err = checkServiceConnection(serviceEndpoint); err != nil {
+		return err
+	}
+	flattenServiceEndpointTerraformCloud(d, serviceEndpoint)
+	return nil
+}
+
+func resourceServiceEndpointTerraformCloudUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint := expandServiceEndpointTerraformCloud(d)
+	if err := validateRotatedServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return err
+	}
+
+	if _, err := updateServiceEndpoint(d, clients, serviceEndpoint); err != nil {
+		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
+	}
+
+	return resourceServiceEndpointTerraformCloudRead(d, m)
+}
+
+func resourceServiceEndpointTerraformCloudDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint := expandServiceEndpointTerraformCloud(d)
+	return deleteServiceEndpoint(clients, serviceEndpoint, d.Timeout(schema.TimeoutDelete))
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointTerraformCloud(d *schema.ResourceData) *serviceendpoint.ServiceEndpoint {
+	serviceEndpoint := doBaseExpansion(d)
+	serviceEndpoint.Type = converter.String("terraformcloud")
+	serviceEndpoint.Url = converter.String(d.Get("url").(string))
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"apitoken": d.Get("api_token").(string),
+		},
+		Scheme: converter.String("Token"),
+	}
+	if organization := d.Get("organization").(string); organization != "" {
+		serviceEndpoint.Data = &map[string]string{
+			"organization": organization,
+		}
+	}
+	return serviceEndpoint
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenServiceEndpointTerraformCloud(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint) {
+	doBaseFlattening(d, serviceEndpoint)
+
+	d.Set("url", *serviceEndpoint.Url)
+	if serviceEndpoint.Data != nil {
+		d.Set("organization", (*serviceEndpoint.Data)["organization"])
+	}
+	d.Set("api_token", d.Get("api_token").(string))
+}
@@ -0,0 +1,184 @@
+//go:build (all || resource_serviceendpoint_terraform_cloud) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_terraform_cloud
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	terraformCloudTestServiceEndpointID          = uuid.New()
+	terraformCloudRandomServiceEndpointProjectID = uuid.New()
+	terraformCloudTestServiceEndpointProjectID   = &terraformCloudRandomServiceEndpointProjectID
+)
+
+var terraformCloudTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"apitoken": "UNIT_TEST_TOKEN",
+		},
+		Scheme: converter.String("Token"),
+	},
+	Id:          &terraformCloudTestServiceEndpointID,
+	Name:        converter.String("UNIT_TEST_CONN_NAME"),
+	Owner:       converter.String("library"),
+	Type:        converter.String("terraformcloud"),
+	Url:         converter.String("https://app.terraform.io"),
+	Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+	Data: &map[string]string{
+		"organization": "UNIT_TEST_ORGANIZATION",
+	},
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: terraformCloudTestServiceEndpointProjectID,
+			},
+			Name:        converter.String("UNIT_TEST_CONN_NAME"),
+			Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestServiceEndpointTerraformCloud_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointTerraformCloud().Schema, nil)
+	resourceData.Set("project_id", (*terraformCloudTestServiceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	resourceData.Set("api_token", "UNIT_TEST_TOKEN")
+	flattenServiceEndpointTerraformCloud(resourceData, &terraformCloudTestServiceEndpoint)
+
+	serviceEndpointAfterRoundTrip := expandServiceEndpointTerraformCloud(resourceData)
+
+	require.Equal(t, terraformCloudTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, terraformCloudTestServiceEndpointProjectID, (*serviceEndpointAfterRoundTrip.ServiceEndpointProjectReferences)[0].ProjectReference.Id)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointTerraformCloud_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointTerraformCloud()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("project_id", (*terraformCloudTestServiceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	resourceData.Set("api_token", "UNIT_TEST_TOKEN")
+	flattenServiceEndpointTerraformCloud(resourceData, &terraformCloudTestServiceEndpoint)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &terraformCloudTestServiceEndpoint}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestServiceEndpointTerraformCloud_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointTerraformCloud()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("project_id", (*terraformCloudTestServiceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	resourceData.Set("api_token", "UNIT_TEST_TOKEN")
+	flattenServiceEndpointTerraformCloud(resourceData, &terraformCloudTestServiceEndpoint)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+		EndpointId: terraformCloudTestServiceEndpoint.Id,
+		Project:    converter.String(terraformCloudTestServiceEndpointProjectID.String()),
+	}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestServiceEndpointTerraformCloud_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointTerraformCloud()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("project_id", (*terraformCloudTestServiceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	resourceData.Set("api_token", "UNIT_TEST_TOKEN")
+	flattenServiceEndpointTerraformCloud(resourceData, &terraformCloudTestServiceEndpoint)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{
+		EndpointId: terraformCloudTestServiceEndpoint.Id,
+		ProjectIds: &[]string{
+			terraformCloudTestServiceEndpointProjectID.String(),
+		},
+	}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestServiceEndpointTerraformCloud_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointTerraformCloud()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("project_id", (*terraformCloudTestServiceEndpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+	resourceData.Set("api_token", "UNIT_TEST_TOKEN")
+	flattenServiceEndpointTerraformCloud(resourceData, &terraformCloudTestServiceEndpoint)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &terraformCloudTestServiceEndpoint,
+		EndpointId: terraformCloudTestServiceEndpoint.Id,
+	}
+
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(expectedArgs.Endpoint, nil).
+		Times(1)
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
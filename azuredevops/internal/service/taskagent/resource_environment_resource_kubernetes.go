@@ -3,11 +3,13 @@ package taskagent
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
@@ -50,14 +52,15 @@ func ResourceEnvironmentKubernetes() *schema.Resource {
 			},
 			"namespace": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"cluster_name": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
-				Default:  "",
 			},
 			"tags": {
 				Type:     schema.TypeSet,
@@ -80,6 +83,12 @@ func resourceEnvironmentKubernetesCreate(d *schema.ResourceData, m interface{})
 	}
 
 	clients := m.(*client.AggregatedClient)
+	if converter.ToString(resource.Namespace, "") == "" || converter.ToString(resource.ClusterName, "") == "" {
+		if err := expandEnvironmentKubernetesResourceFromServiceEndpoint(clients, project, resource); err != nil {
+			return err
+		}
+	}
+
 	createdResource, err := clients.TaskAgentClient.AddKubernetesResourcExistingEndpoint(clients.Ctx, taskagent.AddKubernetesResourceArgsExistingEndpoint{
 		CreateParameters: &taskagent.KubernetesResourceCreateParametersExistingEndpoint{
 			ClusterName:       resource.ClusterName,
@@ -180,6 +189,40 @@ func expandEnvironmentKubernetesResource(d *schema.ResourceData) (*taskagent.Pro
 	return project, resource, nil
 }
 
+// expandEnvironmentKubernetesResourceFromServiceEndpoint defaults the namespace and the cluster name of the resource
+// to the ones configured on the Kubernetes service endpoint
+func expandEnvironmentKubernetesResourceFromServiceEndpoint(clients *client.AggregatedClient, project *taskagent.ProjectReference, resource *taskagent.KubernetesResource) error {
+	endpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+		EndpointId: resource.ServiceEndpointId,
+		Project:    converter.String(project.Id.String()),
+	})
+	if err != nil {
+		return fmt.Errorf("reading the Kubernetes service endpoint %s: %+v", resource.ServiceEndpointId, err)
+	}
+	if endpoint == nil || endpoint.Id == nil {
+		return fmt.Errorf("the Kubernetes service endpoint %s does not exist in project %s", resource.ServiceEndpointId, project.Id)
+	}
+	if !strings.EqualFold(converter.ToString(endpoint.Type, ""), "kubernetes") {
+		return fmt.Errorf("the service endpoint %s is not a Kubernetes service endpoint", resource.ServiceEndpointId)
+	}
+
+	data := map[string]string{}
+	if endpoint.Data != nil {
+		data = *endpoint.Data
+	}
+	if converter.ToString(resource.Namespace, "") == "" {
+		if data["namespace"] == "" {
+			return fmt.Errorf("the Kubernetes service endpoint %s does not define a namespace, `namespace` must be set", resource.ServiceEndpointId)
+		}
+		resource.Namespace = converter.String(data["namespace"])
+	}
+	if converter.ToString(resource.ClusterName, "") == "" && data["clusterId"] != "" {
+		clusterID := strings.Split(data["clusterId"], "/")
+		resource.ClusterName = converter.String(clusterID[len(clusterID)-1])
+	}
+	return nil
+}
+
 func flattenEnvironmentKubernetesResource(d *schema.ResourceData, project *taskagent.ProjectReference, resource *taskagent.KubernetesResource) {
 	d.Set("cluster_name", converter.ToString(resource.ClusterName, ""))
 	d.Set("name", *resource.Name)
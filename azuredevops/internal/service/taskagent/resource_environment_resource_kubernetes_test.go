@@ -18,6 +18,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/stretchr/testify/require"
@@ -108,6 +109,91 @@ func TestEnvironmentKubernetesResource_CreateKubernetesResource(t *testing.T) {
 	assert.Equal(t, testEnvironmentKubernetesResource, *resource)
 }
 
+func TestEnvironmentKubernetesResource_CreateKubernetesResourceDefaultsFromServiceEndpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient:       taskAgentClient,
+		ServiceEndpointClient: serviceEndpointClient,
+		Ctx:                   context.Background(),
+	}
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: &testEnvironmentKubernetesResourceServiceEndpointId,
+			Project:    converter.String(testEnvironmentKubernetesResourceProjectId.String()),
+		}).
+		Return(&serviceendpoint.ServiceEndpoint{
+			Id:   &testEnvironmentKubernetesResourceServiceEndpointId,
+			Type: converter.String("kubernetes"),
+			Data: &map[string]string{
+				"authorizationType": "WorkloadIdentityFederation",
+				"clusterId":         "/subscriptions/sub/resourcegroups/rg/providers/Microsoft.ContainerService/managedClusters/Test Cluster",
+				"namespace":         "Test Namespace",
+			},
+		}, nil).
+		Times(1)
+
+	taskAgentClient.
+		EXPECT().
+		AddKubernetesResourcExistingEndpoint(clients.Ctx, taskagent.AddKubernetesResourceArgsExistingEndpoint{
+			CreateParameters: &taskagent.KubernetesResourceCreateParametersExistingEndpoint{
+				ClusterName:       testEnvironmentKubernetesResource.ClusterName,
+				Name:              testEnvironmentKubernetesResource.Name,
+				Namespace:         testEnvironmentKubernetesResource.Namespace,
+				Tags:              testEnvironmentKubernetesResource.Tags,
+				ServiceEndpointId: testEnvironmentKubernetesResource.ServiceEndpointId,
+			},
+			Project:       converter.String(testEnvironmentKubernetesResourceProject.Id.String()),
+			EnvironmentId: testEnvironmentKubernetesResource.EnvironmentReference.Id,
+		}).
+		Return(nil, errors.New("test error")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceEnvironmentKubernetes().Schema, nil)
+	flattenEnvironmentKubernetesResource(resourceData, &testEnvironmentKubernetesResourceProject, &testEnvironmentKubernetesResource)
+	resourceData.Set("namespace", "")
+	resourceData.Set("cluster_name", "")
+	err := resourceEnvironmentKubernetesCreate(resourceData, clients)
+	assert.Contains(t, err.Error(), "test error")
+}
+
+func TestEnvironmentKubernetesResource_CreateKubernetesResourceRequiresKubernetesServiceEndpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient:       taskAgentClient,
+		ServiceEndpointClient: serviceEndpointClient,
+		Ctx:                   context.Background(),
+	}
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(&serviceendpoint.ServiceEndpoint{
+			Id:   &testEnvironmentKubernetesResourceServiceEndpointId,
+			Type: converter.String("azurerm"),
+		}, nil).
+		Times(1)
+	taskAgentClient.
+		EXPECT().
+		AddKubernetesResourcExistingEndpoint(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceEnvironmentKubernetes().Schema, nil)
+	flattenEnvironmentKubernetesResource(resourceData, &testEnvironmentKubernetesResourceProject, &testEnvironmentKubernetesResource)
+	resourceData.Set("namespace", "")
+	err := resourceEnvironmentKubernetesCreate(resourceData, clients)
+	assert.Contains(t, err.Error(), "is not a Kubernetes service endpoint")
+}
+
 func TestEnvironmentKubernetesResource_CreateKubernetesResourceReturnsErrorOnFailure(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
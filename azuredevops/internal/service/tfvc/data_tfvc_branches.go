@@ -0,0 +1,116 @@
+package tfvc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/tfvc"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataTfvcBranches schema and implementation for TFVC branches data source
+func DataTfvcBranches() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTfvcBranchesRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"include_deleted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"branches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_deleted": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTfvcBranchesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	project, err := clients.CoreClient.GetProject(clients.Ctx, core.GetProjectArgs{
+		ProjectId: converter.String(projectID),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("reading project %s: %+v", projectID, err))
+	}
+
+	branches, err := clients.TfvcClient.GetBranchRefs(clients.Ctx, tfvc.GetBranchRefsArgs{
+		ScopePath:      converter.String("$/" + *project.Name),
+		Project:        converter.String(projectID),
+		IncludeDeleted: converter.Bool(d.Get("include_deleted").(bool)),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("listing TFVC branches of project %s: %+v", projectID, err))
+	}
+
+	d.SetId("tfvcBranches-" + projectID)
+	if err := d.Set("branches", flattenTfvcBranches(branches)); err != nil {
+		return diag.FromErr(fmt.Errorf("setting branches: %+v", err))
+	}
+	return nil
+}
+
+func flattenTfvcBranches(branches *[]git.TfvcBranchRef) []interface{} {
+	if branches == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0, len(*branches))
+	for _, branch := range *branches {
+		result := map[string]interface{}{
+			"path":        converter.ToString(branch.Path, ""),
+			"description": converter.ToString(branch.Description, ""),
+			"is_deleted":  converter.ToBool(branch.IsDeleted, false),
+		}
+		if branch.Owner != nil {
+			result["owner"] = converter.ToString(branch.Owner.UniqueName, converter.ToString(branch.Owner.DisplayName, ""))
+		}
+		if branch.CreatedDate != nil {
+			result["created_date"] = branch.CreatedDate.Time.Format(time.RFC3339)
+		}
+		results = append(results, result)
+	}
+	return results
+}
@@ -0,0 +1,155 @@
+//go:build (all || tfvc || data_sources || data_tfvc_branches) && (!exclude_data_sources || !exclude_tfvc || !exclude_data_tfvc_branches)
+// +build all tfvc data_sources data_tfvc_branches
+// +build !exclude_data_sources !exclude_tfvc !exclude_data_tfvc_branches
+
+package tfvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/tfvc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testTfvcProjectID = uuid.New()
+
+func TestDataSourceTfvcBranches_Read_FlattensBranches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	tfvcClient := azdosdkmocks.NewMockTfvcClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient: coreClient,
+		TfvcClient: tfvcClient,
+		Ctx:        context.Background(),
+	}
+
+	coreClient.
+		EXPECT().
+		GetProject(clients.Ctx, core.GetProjectArgs{ProjectId: converter.String(testTfvcProjectID.String())}).
+		Return(&core.TeamProject{Id: &testTfvcProjectID, Name: converter.String("Example Project")}, nil).
+		Times(1)
+
+	createdDate := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	tfvcClient.
+		EXPECT().
+		GetBranchRefs(clients.Ctx, tfvc.GetBranchRefsArgs{
+			ScopePath:      converter.String("$/Example Project"),
+			Project:        converter.String(testTfvcProjectID.String()),
+			IncludeDeleted: converter.Bool(true),
+		}).
+		Return(&[]git.TfvcBranchRef{
+			{
+				Path:        converter.String("$/Example Project/Main"),
+				Description: converter.String("Main branch"),
+				CreatedDate: &azuredevops.Time{Time: createdDate},
+				IsDeleted:   converter.Bool(false),
+				Owner:       &webapi.IdentityRef{UniqueName: converter.String("owner@example.com"), DisplayName: converter.String("Owner")},
+			},
+			{
+				Path:      converter.String("$/Example Project/Old"),
+				IsDeleted: converter.Bool(true),
+				Owner:     &webapi.IdentityRef{DisplayName: converter.String("Former Owner")},
+			},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataTfvcBranches().Schema, map[string]interface{}{
+		"project_id":      testTfvcProjectID.String(),
+		"include_deleted": true,
+	})
+
+	diags := dataSourceTfvcBranchesRead(context.Background(), resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "tfvcBranches-"+testTfvcProjectID.String(), resourceData.Id())
+
+	branches := resourceData.Get("branches").([]interface{})
+	require.Len(t, branches, 2)
+	require.Equal(t, map[string]interface{}{
+		"path":         "$/Example Project/Main",
+		"description":  "Main branch",
+		"owner":        "owner@example.com",
+		"created_date": "2024-05-01T10:00:00Z",
+		"is_deleted":   false,
+	}, branches[0])
+	require.Equal(t, "Former Owner", branches[1].(map[string]interface{})["owner"])
+	require.Equal(t, true, branches[1].(map[string]interface{})["is_deleted"])
+}
+
+func TestDataSourceTfvcBranches_Read_DoesNotSwallowProjectError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	tfvcClient := azdosdkmocks.NewMockTfvcClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient: coreClient,
+		TfvcClient: tfvcClient,
+		Ctx:        context.Background(),
+	}
+
+	coreClient.
+		EXPECT().
+		GetProject(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetProject() Failed")).
+		Times(1)
+	tfvcClient.
+		EXPECT().
+		GetBranchRefs(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resourceData := schema.TestResourceDataRaw(t, DataTfvcBranches().Schema, map[string]interface{}{
+		"project_id": testTfvcProjectID.String(),
+	})
+
+	diags := dataSourceTfvcBranchesRead(context.Background(), resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "GetProject() Failed")
+}
+
+func TestDataSourceTfvcBranches_Read_DoesNotSwallowBranchError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	tfvcClient := azdosdkmocks.NewMockTfvcClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient: coreClient,
+		TfvcClient: tfvcClient,
+		Ctx:        context.Background(),
+	}
+
+	coreClient.
+		EXPECT().
+		GetProject(clients.Ctx, gomock.Any()).
+		Return(&core.TeamProject{Id: &testTfvcProjectID, Name: converter.String("Example Project")}, nil).
+		Times(1)
+	tfvcClient.
+		EXPECT().
+		GetBranchRefs(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetBranchRefs() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataTfvcBranches().Schema, map[string]interface{}{
+		"project_id": testTfvcProjectID.String(),
+	})
+
+	diags := dataSourceTfvcBranchesRead(context.Background(), resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "GetBranchRefs() Failed")
+	require.Equal(t, "", resourceData.Id())
+}
@@ -37,6 +37,18 @@ func ParseGitRepoBranchID(id string) (string, string, error) {
 	return parseTwoPartID(id, ":", "repositoryID:branchName")
 }
 
+func ParseGitRepoPullRequestID(id string) (string, int, error) {
+	repoId, prId, err := parseTwoPartID(id, ":", "repositoryID:pullRequestID")
+	if err != nil {
+		return "", 0, err
+	}
+	pullRequestId, err := strconv.Atoi(prId)
+	if err != nil {
+		return "", 0, fmt.Errorf("unexpected format of ID (%s), pull request ID %q is not a number", id, prId)
+	}
+	return repoId, pullRequestId, nil
+}
+
 func parseTwoPartID(id, sep, want string) (string, string, error) {
 	parts := strings.SplitN(id, sep, 2)
 	if len(parts) != 2 || strings.EqualFold(parts[0], "") || strings.EqualFold(parts[1], "") {
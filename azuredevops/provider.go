@@ -33,6 +33,7 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/servicehook"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/tfvc"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/wiki"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/workitemtracking"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/workitemtrackingprocess"
@@ -43,9 +44,12 @@ func Provider() *schema.Provider {
 	p := &schema.Provider{
 		ResourcesMap: map[string]*schema.Resource{
 			"azuredevops_agent_pool":                                  taskagent.ResourceAgentPool(),
+			"azuredevops_agent_pool_permissions":                      permissions.ResourceAgentPoolPermissions(),
 			"azuredevops_agent_queue":                                 taskagent.ResourceAgentQueue(),
+			"azuredevops_analytics_view_permissions":                  permissions.ResourceAnalyticsViewPermissions(),
 			"azuredevops_area":                                        workitemtracking.ResourceArea(),
 			"azuredevops_area_permissions":                            permissions.ResourceAreaPermissions(),
+			"azuredevops_audit_log_permissions":                       permissions.ResourceAuditLogPermissions(),
 			"azuredevops_branch_policy_auto_reviewers":                branch.ResourceBranchPolicyAutoReviewers(),
 			"azuredevops_branch_policy_build_validation":              branch.ResourceBranchPolicyBuildValidation(),
 			"azuredevops_branch_policy_comment_resolution":            branch.ResourceBranchPolicyCommentResolution(),
@@ -64,15 +68,21 @@ func Provider() *schema.Provider {
 			"azuredevops_check_required_template":                     approvalsandchecks.ResourceCheckRequiredTemplate(),
 			"azuredevops_check_rest_api":                              approvalsandchecks.ResourceCheckRestAPI(),
 			"azuredevops_dashboard":                                   dashboard.ResourceDashboard(),
+			"azuredevops_dashboard_permissions":                       permissions.ResourceDashboardPermissions(),
+			"azuredevops_delivery_plan_permissions":                   permissions.ResourceDeliveryPlanPermissions(),
 			"azuredevops_deployment_group":                            taskagent.ResourceDeploymentGroup(),
 			"azuredevops_elastic_pool":                                taskagent.ResourceAgentPoolVMSS(),
 			"azuredevops_environment":                                 taskagent.ResourceEnvironment(),
+			"azuredevops_environment_permissions":                     permissions.ResourceEnvironmentPermissions(),
 			"azuredevops_environment_resource_kubernetes":             taskagent.ResourceEnvironmentKubernetes(),
 			"azuredevops_extension":                                   extension.ResourceExtension(),
 			"azuredevops_feed":                                        feed.ResourceFeed(),
 			"azuredevops_feed_permission":                             feed.ResourceFeedPermission(),
 			"azuredevops_feed_retention_policy":                       feed.ResourceFeedRetentionPolicy(),
+			"azuredevops_git_commit_status":                           git.ResourceGitCommitStatus(),
 			"azuredevops_git_permissions":                             permissions.ResourceGitPermissions(),
+			"azuredevops_git_permissions_acl":                         permissions.ResourceGitPermissionsAcl(),
+			"azuredevops_git_pull_request":                            git.ResourceGitPullRequest(),
 			"azuredevops_git_repository":                              git.ResourceGitRepository(),
 			"azuredevops_git_repository_branch":                       git.ResourceGitRepositoryBranch(),
 			"azuredevops_git_repository_file":                         git.ResourceGitRepositoryFile(),
@@ -95,6 +105,7 @@ func Provider() *schema.Provider {
 			"azuredevops_repository_policy_max_path_length":           repository.ResourceRepositoryMaxPathLength(),
 			"azuredevops_repository_policy_reserved_names":            repository.ResourceRepositoryReservedNames(),
 			"azuredevops_resource_authorization":                      build.ResourceResourceAuthorization(),
+			"azuredevops_secure_file_permissions":                     permissions.ResourceSecureFilePermissions(),
 			"azuredevops_security_permissions":                        security.ResourceGenericPermissions(),
 			"azuredevops_securityrole_assignment":                     securityroles.ResourceSecurityRoleAssignment(),
 			"azuredevops_serviceendpoint_generic_v2":                  serviceendpoint.ResourceServiceEndpointGenericV2(),
@@ -133,12 +144,16 @@ func Provider() *schema.Provider {
 			"azuredevops_serviceendpoint_octopusdeploy":               serviceendpoint.ResourceServiceEndpointOctopusDeploy(),
 			"azuredevops_serviceendpoint_openshift":                   serviceendpoint.ResourceServiceEndpointOpenshift(),
 			"azuredevops_serviceendpoint_permissions":                 permissions.ResourceServiceEndpointPermissions(),
+			"azuredevops_serviceendpoint_pypi_download":               serviceendpoint.ResourceServiceEndpointPyPIDownload(),
+			"azuredevops_serviceendpoint_pypi_upload":                 serviceendpoint.ResourceServiceEndpointPyPIUpload(),
 			"azuredevops_serviceendpoint_runpipeline":                 serviceendpoint.ResourceServiceEndpointRunPipeline(),
 			"azuredevops_serviceendpoint_servicefabric":               serviceendpoint.ResourceServiceEndpointServiceFabric(),
+			"azuredevops_serviceendpoint_share":                       serviceendpoint.ResourceServiceEndpointShare(),
 			"azuredevops_serviceendpoint_snyk":                        serviceendpoint.ResourceServiceEndpointSnyk(),
 			"azuredevops_serviceendpoint_sonarcloud":                  serviceendpoint.ResourceServiceEndpointSonarCloud(),
 			"azuredevops_serviceendpoint_sonarqube":                   serviceendpoint.ResourceServiceEndpointSonarQube(),
 			"azuredevops_serviceendpoint_ssh":                         serviceendpoint.ResourceServiceEndpointSSH(),
+			"azuredevops_serviceendpoint_terraform_cloud":             serviceendpoint.ResourceServiceEndpointTerraformCloud(),
 			"azuredevops_serviceendpoint_visualstudiomarketplace":     serviceendpoint.ResourceServiceEndpointMarketplace(),
 			"azuredevops_servicehook_permissions":                     permissions.ResourceServiceHookPermissions(),
 			"azuredevops_servicehook_storage_queue_pipelines":         servicehook.ResourceServicehookStorageQueuePipelines(),
@@ -148,7 +163,9 @@ func Provider() *schema.Provider {
 			"azuredevops_team":                                        core.ResourceTeam(),
 			"azuredevops_team_administrators":                         core.ResourceTeamAdministrators(),
 			"azuredevops_team_members":                                core.ResourceTeamMembers(),
+			"azuredevops_tfvc_permissions":                            permissions.ResourceTfvcPermissions(),
 			"azuredevops_user_entitlement":                            memberentitlementmanagement.ResourceUserEntitlement(),
+			"azuredevops_user_entitlements":                           memberentitlementmanagement.ResourceUserEntitlements(),
 			"azuredevops_variable_group":                              taskagent.ResourceVariableGroup(),
 			"azuredevops_variable_group_permissions":                  permissions.ResourceVariableGroupPermissions(),
 			"azuredevops_variable_group_variable":                     taskagent.ResourceVariableGroupVariable(),
@@ -182,6 +199,7 @@ func Provider() *schema.Provider {
 			"azuredevops_build_definition":                      build.DataBuildDefinition(),
 			"azuredevops_client_config":                         service.DataClientConfig(),
 			"azuredevops_descriptor":                            graph.DataDescriptor(),
+			"azuredevops_effective_permissions":                 security.DataEffectivePermissions(),
 			"azuredevops_environment":                           taskagent.DataEnvironment(),
 			"azuredevops_feed":                                  feed.DataFeed(),
 			"azuredevops_git_repositories":                      git.DataGitRepositories(),
@@ -200,9 +218,11 @@ func Provider() *schema.Provider {
 			"azuredevops_security_namespace_token":              security.DataSecurityNamespaceToken(),
 			"azuredevops_security_namespaces":                   security.DataSecurityNamespaces(),
 			"azuredevops_securityrole_definitions":              securityroles.DataSecurityRoleDefinitions(),
+			"azuredevops_serviceendpoint_execution_history":     serviceendpoint.DataServiceEndpointExecutionHistory(),
 			"azuredevops_serviceendpoint_generic_v2":            serviceendpoint.DataServiceEndpointGenericV2(),
 			"azuredevops_serviceendpoint_type":                  serviceendpoint.DataServiceEndpointType(),
 			"azuredevops_serviceendpoint_types":                 serviceendpoint.DataServiceEndpointTypes(),
+			"azuredevops_serviceendpoints":                      serviceendpoint.DataServiceEndpoints(),
 			"azuredevops_serviceendpoint_azurecr":               serviceendpoint.DataResourceServiceEndpointAzureCR(),
 			"azuredevops_serviceendpoint_azurerm":               serviceendpoint.DataServiceEndpointAzureRM(),
 			"azuredevops_serviceendpoint_bitbucket":             serviceendpoint.DataResourceServiceEndpointBitbucket(),
@@ -211,10 +231,13 @@ func Provider() *schema.Provider {
 			"azuredevops_serviceendpoint_npm":                   serviceendpoint.DataResourceServiceEndpointNpm(),
 			"azuredevops_serviceendpoint_sonarcloud":            serviceendpoint.DataResourceServiceEndpointSonarCloud(),
 			"azuredevops_service_principal":                     graph.DataServicePrincipal(),
+			"azuredevops_service_principals":                    graph.DataServicePrincipals(),
 			"azuredevops_storage_key":                           graph.DataStorageKey(),
 			"azuredevops_team":                                  core.DataTeam(),
 			"azuredevops_teams":                                 core.DataTeams(),
+			"azuredevops_tfvc_branches":                         tfvc.DataTfvcBranches(),
 			"azuredevops_user":                                  graph.DataUser(),
+			"azuredevops_user_entitlements":                     memberentitlementmanagement.DataUserEntitlements(),
 			"azuredevops_users":                                 graph.DataUsers(),
 			"azuredevops_variable_group":                        taskagent.DataVariableGroup(),
 			"azuredevops_workitemtrackingprocess_process":       workitemtrackingprocess.DataProcess(),
@@ -11,9 +11,12 @@ import (
 func TestProvider_HasChildResources(t *testing.T) {
 	expectedResources := []string{
 		"azuredevops_agent_pool",
+		"azuredevops_agent_pool_permissions",
 		"azuredevops_agent_queue",
+		"azuredevops_analytics_view_permissions",
 		"azuredevops_area",
 		"azuredevops_area_permissions",
+		"azuredevops_audit_log_permissions",
 		"azuredevops_branch_policy_auto_reviewers",
 		"azuredevops_branch_policy_build_validation",
 		"azuredevops_branch_policy_comment_resolution",
@@ -32,15 +35,21 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_check_required_template",
 		"azuredevops_check_rest_api",
 		"azuredevops_dashboard",
+		"azuredevops_dashboard_permissions",
+		"azuredevops_delivery_plan_permissions",
 		"azuredevops_deployment_group",
 		"azuredevops_elastic_pool",
 		"azuredevops_environment",
+		"azuredevops_environment_permissions",
 		"azuredevops_environment_resource_kubernetes",
 		"azuredevops_extension",
 		"azuredevops_feed",
 		"azuredevops_feed_permission",
 		"azuredevops_feed_retention_policy",
+		"azuredevops_git_commit_status",
 		"azuredevops_git_permissions",
+		"azuredevops_git_permissions_acl",
+		"azuredevops_git_pull_request",
 		"azuredevops_git_repository",
 		"azuredevops_git_repository_branch",
 		"azuredevops_git_repository_file",
@@ -63,6 +72,7 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_repository_policy_max_path_length",
 		"azuredevops_repository_policy_reserved_names",
 		"azuredevops_resource_authorization",
+		"azuredevops_secure_file_permissions",
 		"azuredevops_security_permissions",
 		"azuredevops_securityrole_assignment",
 		"azuredevops_serviceendpoint_generic_v2",
@@ -101,12 +111,16 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_serviceendpoint_octopusdeploy",
 		"azuredevops_serviceendpoint_openshift",
 		"azuredevops_serviceendpoint_permissions",
+		"azuredevops_serviceendpoint_pypi_download",
+		"azuredevops_serviceendpoint_pypi_upload",
 		"azuredevops_serviceendpoint_runpipeline",
 		"azuredevops_serviceendpoint_servicefabric",
+		"azuredevops_serviceendpoint_share",
 		"azuredevops_serviceendpoint_snyk",
 		"azuredevops_serviceendpoint_sonarcloud",
 		"azuredevops_serviceendpoint_sonarqube",
 		"azuredevops_serviceendpoint_ssh",
+		"azuredevops_serviceendpoint_terraform_cloud",
 		"azuredevops_serviceendpoint_visualstudiomarketplace",
 		"azuredevops_servicehook_permissions",
 		"azuredevops_servicehook_storage_queue_pipelines",
@@ -116,7 +130,9 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_team",
 		"azuredevops_team_administrators",
 		"azuredevops_team_members",
+		"azuredevops_tfvc_permissions",
 		"azuredevops_user_entitlement",
+		"azuredevops_user_entitlements",
 		"azuredevops_variable_group",
 		"azuredevops_variable_group_permissions",
 		"azuredevops_variable_group_variable",
@@ -161,6 +177,7 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 		"azuredevops_build_definition",
 		"azuredevops_client_config",
 		"azuredevops_descriptor",
+		"azuredevops_effective_permissions",
 		"azuredevops_environment",
 		"azuredevops_feed",
 		"azuredevops_git_repositories",
@@ -179,6 +196,7 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 		"azuredevops_security_namespace_token",
 		"azuredevops_security_namespaces",
 		"azuredevops_securityrole_definitions",
+		"azuredevops_serviceendpoint_execution_history",
 		"azuredevops_serviceendpoint_generic_v2",
 		"azuredevops_serviceendpoint_azurecr",
 		"azuredevops_serviceendpoint_azurerm",
@@ -188,12 +206,16 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 		"azuredevops_serviceendpoint_npm",
 		"azuredevops_serviceendpoint_type",
 		"azuredevops_serviceendpoint_types",
+		"azuredevops_serviceendpoints",
 		"azuredevops_serviceendpoint_sonarcloud",
 		"azuredevops_storage_key",
 		"azuredevops_service_principal",
+		"azuredevops_service_principals",
 		"azuredevops_team",
 		"azuredevops_teams",
+		"azuredevops_tfvc_branches",
 		"azuredevops_user",
+		"azuredevops_user_entitlements",
 		"azuredevops_users",
 		"azuredevops_variable_group",
 		"azuredevops_workitemtrackingprocess_process",
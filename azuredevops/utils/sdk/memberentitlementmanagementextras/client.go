@@ -0,0 +1,61 @@
+// This is a copy of the SearchUserEntitlements function of github.com/microsoft/azure-devops-go-api/azuredevops/memberentitlementmanagement/client.go
+// The existing version does not return the continuation token of the paged result
+
+// This file cannot be under "internal", because azdosdkmocks/memberentitlementmanagementextras_sdk_mock.go depends on it.
+
+package memberentitlementmanagementextras
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+)
+
+type Client interface {
+	// [Preview API] Get a paged set of user entitlements matching the filter and sort criteria built with properties that match the select input.
+	SearchUserEntitlements(context.Context, memberentitlementmanagement.SearchUserEntitlementsArgs) (*PagedUserEntitlements, error)
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := connection.GetClientByResourceAreaId(ctx, memberentitlementmanagement.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// [Preview API] Get a paged set of user entitlements matching the filter and sort criteria built with properties that match the select input.
+func (client *ClientImpl) SearchUserEntitlements(ctx context.Context, args memberentitlementmanagement.SearchUserEntitlementsArgs) (*PagedUserEntitlements, error) {
+	queryParams := url.Values{}
+	if args.ContinuationToken != nil {
+		queryParams.Add("continuationToken", *args.ContinuationToken)
+	}
+	if args.Select != nil {
+		queryParams.Add("select", string(*args.Select))
+	}
+	if args.Filter != nil {
+		queryParams.Add("$filter", *args.Filter)
+	}
+	if args.OrderBy != nil {
+		queryParams.Add("$orderBy", *args.OrderBy)
+	}
+	locationId, _ := uuid.Parse("387f832c-dbf2-4643-88e9-c1aa94dbb737") //nolint:errcheck
+	resp, err := client.Client.Send(ctx, http.MethodGet, locationId, "7.1-preview.3", nil, queryParams, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue PagedUserEntitlements
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
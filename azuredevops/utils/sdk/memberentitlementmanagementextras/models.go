@@ -0,0 +1,18 @@
+// This is an extended copy of the PagedGraphMemberList struct of github.com/microsoft/azure-devops-go-api/azuredevops/memberentitlementmanagement/models.go
+// The existing version does not contain the "ContinuationToken" property
+
+// This file cannot be under "internal", because azdosdkmocks/memberentitlementmanagementextras_sdk_mock.go depends on it.
+
+package memberentitlementmanagementextras
+
+import (
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+)
+
+// A paged set of user entitlements
+type PagedUserEntitlements struct {
+	// The continuation token to get the next page, empty if the page is the last one
+	ContinuationToken *string `json:"continuationToken,omitempty"`
+	// The user entitlements of the page
+	Members *[]memberentitlementmanagement.UserEntitlement `json:"members,omitempty"`
+}